@@ -13,7 +13,7 @@ resource "fusion_tenant_space" "fts" {
 resource "fusion_host_access_policy" "host_access_policy" {
   name          = "testhap"
   display_name  = "TestHostAccessPlcy"
-  iqn           = "iqn.year-mo.org.debian:XX:XXXXXXXXXXXX"
+  iqn           = "iqn.2022-05.org.debian:01:0123456789ab"
   personality   = "linux"
 }
 
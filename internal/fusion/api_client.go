@@ -0,0 +1,240 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	context "context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+const apiClientKeyBits = 2048
+
+var apiClientResourceFunctions *BaseResourceFunctions
+
+// Implements ResourceProvider
+//
+// API clients are created and deleted synchronously, there is no operation to wait on.
+type apiClientProvider struct {
+	BaseResourceProvider
+}
+
+// This is our entry point for the API Client resource. Get it movin'
+func resourceApiClient() *schema.Resource {
+	vp := &apiClientProvider{BaseResourceProvider{ResourceKind: "ApiClient"}}
+	apiClientResourceFunctions = NewBaseResourceFunctions("ApiClient", vp)
+
+	apiClientResourceFunctions.Resource.Schema = map[string]*schema.Schema{
+		"display_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"public_key": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validatePublicKey,
+			DiffSuppressFunc: suppressEquivalentPEM,
+			Description:      "PEM encoded RSA public key. An RSA key pair is generated when not set.",
+		},
+		"private_key": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "PEM encoded RSA private key, only set when the key pair was generated.",
+		},
+		"rotation_trigger": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Changing this replaces the API client and its key, e.g. feed it from a `time_rotating` resource.",
+		},
+		"rotation_days": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ForceNew:         true,
+			ConflictsWith:    []string{"public_key"},
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			Description:      "Replace the API client and generate a new key pair once the key is this many days old.",
+		},
+		"rotate_after": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "RFC3339 time after which the next plan replaces the key, when `rotation_days` is set.",
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"issuer": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The issuer id to authenticate as this API client, e.g. in the `issuer_id` of another provider block.",
+		},
+		"creator_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"last_key_update": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Milliseconds since the epoch.",
+		},
+		"last_used": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Milliseconds since the epoch.",
+		},
+	}
+	// The API can't modify API clients, every change to the arguments is a replacement.
+	apiClientResourceFunctions.Resource.UpdateContext = nil
+	apiClientResourceFunctions.AddCustomizeDiff(rotateApiClientKey)
+
+	return apiClientResourceFunctions.Resource
+}
+
+func (vp *apiClientProvider) PrepareCreate(ctx context.Context, d *schema.ResourceData) (InvokeWriteAPI, ResourcePost, error) {
+	body := hmrest.ApiClientPost{
+		DisplayName: rdString(ctx, d, "display_name"),
+		PublicKey:   rdString(ctx, d, "public_key"),
+	}
+
+	privateKey := ""
+	if body.PublicKey == "" {
+		var err error
+		if privateKey, body.PublicKey, err = generateApiClientKey(); err != nil {
+			return nil, nil, err
+		}
+	}
+	d.Set("private_key", privateKey)
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		apiClient, _, err := client.IdentityManagerApi.CreateApiClient(ctx, *body.(*hmrest.ApiClientPost), nil)
+		if err != nil {
+			return nil, err
+		}
+		return completedOperation(apiClient.Id, apiClient.Name), nil
+	}
+	return fn, &body, nil
+}
+
+func (vp *apiClientProvider) ReadResource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	apiClient, _, err := client.IdentityManagerApi.GetApiClient(ctx, d.Id(), nil)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", apiClient.Name)
+	d.Set("display_name", apiClient.DisplayName)
+	d.Set("public_key", apiClient.PublicKey)
+	d.Set("issuer", apiClient.Issuer)
+	d.Set("creator_id", apiClient.CreatorId)
+	d.Set("last_key_update", int64(apiClient.LastKeyUpdate))
+	d.Set("last_used", int64(apiClient.LastUsed))
+
+	rotateAfter := ""
+	if days := rdInt(d, "rotation_days"); days > 0 {
+		rotateAfter = apiClientRotateAfter(int64(apiClient.LastKeyUpdate), days).Format(time.RFC3339)
+	}
+	d.Set("rotate_after", rotateAfter)
+	return nil
+}
+
+func (vp *apiClientProvider) PrepareDelete(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, error) {
+	apiClientId := d.Id()
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		apiClient, _, err := client.IdentityManagerApi.DeleteApiClient(ctx, apiClientId, nil)
+		if err != nil {
+			return nil, err
+		}
+		return completedOperation(apiClient.Id, apiClient.Name), nil
+	}
+	return fn, nil
+}
+
+// rotateApiClientKey plans a replacement once the key is older than rotation_days.
+func rotateApiClientKey(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || d.Get("rotation_days").(int) == 0 {
+		return nil
+	}
+	rotateAfter, err := time.Parse(time.RFC3339, d.Get("rotate_after").(string))
+	if err != nil || time.Now().Before(rotateAfter) {
+		return nil
+	}
+	return d.SetNewComputed("rotate_after")
+}
+
+func apiClientRotateAfter(lastKeyUpdate int64, rotationDays int) time.Time {
+	return time.UnixMilli(lastKeyUpdate).UTC().AddDate(0, 0, rotationDays)
+}
+
+// generateApiClientKey returns a new PEM encoded RSA private key and its public key
+func generateApiClientKey() (string, string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, apiClientKeyBits)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate a key pair: %w", err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encode the public key: %w", err)
+	}
+
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	publicKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})
+	return string(privateKeyPEM), string(publicKeyPEM), nil
+}
+
+func validatePublicKey(val interface{}, p cty.Path) diag.Diagnostics {
+	block, _ := pem.Decode([]byte(val.(string)))
+	if block == nil {
+		return publicKeyDiag(p, "public_key must be PEM encoded")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return publicKeyDiag(p, fmt.Sprintf("public_key can't be parsed: %s", err))
+	}
+	if _, ok := key.(*rsa.PublicKey); !ok {
+		return publicKeyDiag(p, "public_key must be an RSA public key")
+	}
+	return nil
+}
+
+func publicKeyDiag(p cty.Path, summary string) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       summary,
+		AttributePath: p,
+	}}
+}
+
+// suppressEquivalentPEM ignores differences in the surrounding whitespace, e.g. from heredocs
+func suppressEquivalentPEM(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+// completedOperation stands in for the operation of APIs which complete synchronously
+func completedOperation(id, name string) *hmrest.Operation {
+	return &hmrest.Operation{
+		Status: "Succeeded",
+		Result: &hmrest.OperationResult{Resource: &hmrest.ResourceReference{Id: id, Name: name}},
+	}
+}
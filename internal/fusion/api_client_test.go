@@ -0,0 +1,203 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Creates an API client with a generated key, then rotates the key
+func TestAccApiClient_generatedKey(t *testing.T) {
+	rNameConfig := acctest.RandomWithPrefix("api_client_test")
+	rName := "fusion_api_client." + rNameConfig
+	displayName := acctest.RandomWithPrefix("api-client-display-name")
+	var issuer string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckApiClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testApiClientConfig(rNameConfig, displayName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "display_name", displayName),
+					resource.TestCheckResourceAttrSet(rName, "issuer"),
+					resource.TestCheckResourceAttrSet(rName, "private_key"),
+					resource.TestCheckResourceAttrSet(rName, "public_key"),
+					resource.TestCheckResourceAttrSet(rName, "rotate_after"),
+					testApiClientExists(rName),
+					testApiClientIssuer(rName, &issuer, false),
+				),
+			},
+			{
+				Config: testApiClientConfig(rNameConfig, displayName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testApiClientExists(rName),
+					testApiClientIssuer(rName, &issuer, true),
+				),
+			},
+		},
+	})
+}
+
+// Creates an API client with a public key generated elsewhere
+func TestAccApiClient_publicKey(t *testing.T) {
+	rNameConfig := acctest.RandomWithPrefix("api_client_test")
+	rName := "fusion_api_client." + rNameConfig
+	displayName := acctest.RandomWithPrefix("api-client-display-name")
+	_, publicKey, err := generateApiClientKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckApiClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "fusion_api_client" "%[1]s" {
+					display_name = "%[2]s"
+					public_key   = <<EOT
+%[3]sEOT
+				}
+				`, rNameConfig, displayName, publicKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "private_key", ""),
+					resource.TestCheckResourceAttrSet(rName, "issuer"),
+					testApiClientExists(rName),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "fusion_api_client" "%[1]s" {
+					display_name = "%[2]s"
+					public_key   = "not a key"
+				}
+				`, rNameConfig, displayName),
+				ExpectError: regexp.MustCompile("public_key must be PEM encoded"),
+			},
+		},
+	})
+}
+
+func TestGenerateApiClientKey(t *testing.T) {
+	privateKeyPEM, publicKeyPEM, err := generateApiClientKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diags := validatePublicKey(publicKeyPEM, cty.Path{}); diags.HasError() {
+		t.Fatalf("generated public key is invalid: %v", diags)
+	}
+
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		t.Fatal("generated private key is not PEM encoded")
+	}
+	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ = pem.Decode([]byte(publicKeyPEM))
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !privateKey.PublicKey.Equal(publicKey.(*rsa.PublicKey)) {
+		t.Error("generated public key doesn't match the private key")
+	}
+}
+
+func TestApiClientRotateAfter(t *testing.T) {
+	lastKeyUpdate := time.Date(2022, 5, 30, 12, 0, 0, 0, time.UTC)
+	expected := time.Date(2022, 6, 29, 12, 0, 0, 0, time.UTC)
+	if rotateAfter := apiClientRotateAfter(lastKeyUpdate.UnixMilli(), 30); !rotateAfter.Equal(expected) {
+		t.Errorf("apiClientRotateAfter = %s, expected %s", rotateAfter, expected)
+	}
+}
+
+// testApiClientIssuer records the issuer of the API client, or checks that it changed when the key was rotated
+func testApiClientIssuer(rName string, issuer *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfApiClient, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rName)
+		}
+		newIssuer := tfApiClient.Primary.Attributes["issuer"]
+		if changed && newIssuer == *issuer {
+			return fmt.Errorf("expected the API client to be replaced, issuer is still %s", newIssuer)
+		}
+		*issuer = newIssuer
+		return nil
+	}
+}
+
+func testApiClientExists(rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfApiClient, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rName)
+		}
+		if tfApiClient.Type != "fusion_api_client" {
+			return fmt.Errorf("expected type: fusion_api_client. Found: %s", tfApiClient.Type)
+		}
+		attrs := tfApiClient.Primary.Attributes
+
+		goclientApiClient, _, err := testAccProvider.Meta().(*hmrest.APIClient).IdentityManagerApi.GetApiClient(context.Background(), tfApiClient.Primary.ID, nil)
+		if err != nil {
+			return fmt.Errorf("go client returned error while searching for %s. Error: %s", tfApiClient.Primary.ID, err)
+		}
+		if goclientApiClient.DisplayName != attrs["display_name"] || goclientApiClient.Issuer != attrs["issuer"] {
+			return fmt.Errorf("terraform api client doesnt match goclients api client")
+		}
+		return nil
+	}
+}
+
+func testCheckApiClientDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*hmrest.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fusion_api_client" {
+			continue
+		}
+
+		_, resp, err := client.IdentityManagerApi.GetApiClient(context.Background(), rs.Primary.ID, nil)
+		if err != nil && resp.StatusCode == http.StatusNotFound {
+			continue
+		} else {
+			return fmt.Errorf("api client may still exist. Expected response code 404, got code %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+func testApiClientConfig(rName, displayName, rotationTrigger string) string {
+	return fmt.Sprintf(`
+	resource "fusion_api_client" "%[1]s" {
+		display_name     = "%[2]s"
+		rotation_trigger = "%[3]s"
+		rotation_days    = 30
+	}
+	`, rName, displayName, rotationTrigger)
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	context "context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+var arrayResourceFunctions *BaseResourceFunctions
+
+// Implements ResourceProvider
+type arrayProvider struct {
+	BaseResourceProvider
+}
+
+// arrayModePatch turns maintenance_mode or unavailable_mode on or off, see ArraysApiService.UpdateArrayMode
+type arrayModePatch struct {
+	Mode  string
+	Value bool
+}
+
+// This is our entry point for the Array resource. Get it movin'
+func resourceArray() *schema.Resource {
+	vp := &arrayProvider{BaseResourceProvider{ResourceKind: "Array"}}
+	arrayResourceFunctions = NewBaseResourceFunctions("Array", vp)
+
+	arrayResourceFunctions.Resource.Schema = map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"region_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"availability_zone_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"host_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The management address of the array, or a host name resolving to it",
+		},
+		"hardware_type": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"appliance_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"apartment_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "As returned by `purearray list`",
+		},
+		"maintenance_mode": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Set while working on the array's hardware, Fusion won't place new volumes on it",
+		},
+		"unavailable_mode": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Marks the array as unavailable or unhealthy",
+		},
+		"hardware_type_ref": computedRefSchema("The hardware type of this array as resolved by Fusion."),
+		"region_ref":        computedRefSchema("The region of this array as resolved by Fusion."),
+	}
+	arrayResourceFunctions.AddImmutableAttributes("name", "region_name", "availability_zone_name")
+
+	return arrayResourceFunctions.Resource
+}
+
+func (vp *arrayProvider) PrepareCreate(ctx context.Context, d *schema.ResourceData) (InvokeWriteAPI, ResourcePost, error) {
+	name := rdString(ctx, d, "name")
+	displayName := rdStringDefault(ctx, d, "display_name", name)
+	regionName := rdString(ctx, d, "region_name")
+	availabilityZoneName := rdString(ctx, d, "availability_zone_name")
+
+	body := hmrest.ArrayPost{
+		Name:         name,
+		DisplayName:  displayName,
+		ApartmentId:  rdString(ctx, d, "apartment_id"),
+		HostName:     rdString(ctx, d, "host_name"),
+		HardwareType: rdString(ctx, d, "hardware_type"),
+		ApplianceId:  rdString(ctx, d, "appliance_id"),
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.ArraysApi.CreateArray(ctx, *body.(*hmrest.ArrayPost), regionName, availabilityZoneName, nil)
+		return &op, err
+	}
+	return fn, &body, nil
+}
+
+// Modes can't be set on creation, the array is patched right after
+func (vp *arrayProvider) PrepareCreatePatches(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, []ResourcePatch, error) {
+	var patches []ResourcePatch
+	for _, mode := range []string{"maintenance_mode", "unavailable_mode"} {
+		if d.Get(mode).(bool) {
+			patches = append(patches, &arrayModePatch{Mode: mode, Value: true})
+		}
+	}
+	return arrayPatchFunc(rdString(ctx, d, "region_name"), rdString(ctx, d, "availability_zone_name"), rdString(ctx, d, "name")), patches, nil
+}
+
+func (vp *arrayProvider) ReadResource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	array, _, err := client.ArraysApi.GetArrayById(ctx, d.Id(), nil)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", array.Name)
+	d.Set("display_name", array.DisplayName)
+	d.Set("availability_zone_name", array.AvailabilityZone.Name)
+	d.Set("host_name", array.HostName)
+	d.Set("appliance_id", array.ApplianceId)
+	d.Set("apartment_id", array.ApartmentId)
+	d.Set("maintenance_mode", array.MaintenanceMode)
+	d.Set("unavailable_mode", array.UnavailableMode)
+
+	hardwareTypeRef := []map[string]interface{}{}
+	if array.HardwareType != nil {
+		d.Set("hardware_type", array.HardwareType.Name)
+		hardwareTypeRef = append(hardwareTypeRef, map[string]interface{}{
+			"id":        array.HardwareType.Id,
+			"name":      array.HardwareType.Name,
+			"kind":      array.HardwareType.Kind,
+			"self_link": array.HardwareType.SelfLink,
+		})
+	}
+	if err := d.Set("hardware_type_ref", hardwareTypeRef); err != nil {
+		return err
+	}
+
+	regionRef := []map[string]interface{}{}
+	if array.Region != nil {
+		d.Set("region_name", array.Region.Name)
+		regionRef = append(regionRef, map[string]interface{}{
+			"id":        array.Region.Id,
+			"name":      array.Region.Name,
+			"kind":      array.Region.Kind,
+			"self_link": array.Region.SelfLink,
+		})
+	}
+	return d.Set("region_ref", regionRef)
+}
+
+func (vp *arrayProvider) PrepareDelete(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, error) {
+	arrayName := rdString(ctx, d, "name")
+	regionName := rdString(ctx, d, "region_name")
+	availabilityZoneName := rdString(ctx, d, "availability_zone_name")
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.ArraysApi.DeleteArray(ctx, regionName, availabilityZoneName, arrayName, nil)
+		return &op, err
+	}
+	return fn, nil
+}
+
+func (vp *arrayProvider) PrepareUpdate(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, []ResourcePatch, error) {
+	var patches []ResourcePatch // *hmrest.ArrayPatch or *arrayModePatch
+
+	arrayName := rdString(ctx, d, "name")
+	regionName := rdString(ctx, d, "region_name")
+	availabilityZoneName := rdString(ctx, d, "availability_zone_name")
+
+	if d.HasChange("display_name") {
+		displayName := rdString(ctx, d, "display_name")
+		tflog.Info(ctx, "Updating", "display_name", displayName)
+		patches = append(patches, &hmrest.ArrayPatch{
+			DisplayName: &hmrest.NullableString{Value: displayName},
+		})
+	}
+	if d.HasChange("host_name") {
+		hostName := rdString(ctx, d, "host_name")
+		tflog.Info(ctx, "Updating", "host_name", hostName)
+		patches = append(patches, &hmrest.ArrayPatch{
+			HostName: &hmrest.NullableString{Value: hostName},
+		})
+	}
+	for _, mode := range []string{"maintenance_mode", "unavailable_mode"} {
+		if d.HasChange(mode) {
+			value := d.Get(mode).(bool)
+			tflog.Info(ctx, "Updating", mode, value)
+			patches = append(patches, &arrayModePatch{Mode: mode, Value: value})
+		}
+	}
+
+	return arrayPatchFunc(regionName, availabilityZoneName, arrayName), patches, nil
+}
+
+func arrayPatchFunc(regionName, availabilityZoneName, arrayName string) InvokeWriteAPI {
+	return func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		switch patch := body.(type) {
+		case *arrayModePatch:
+			op, err := client.ArraysApi.UpdateArrayMode(ctx, regionName, availabilityZoneName, arrayName, patch.Mode, patch.Value, "")
+			return &op, err
+		default:
+			op, _, err := client.ArraysApi.UpdateArray(ctx, *body.(*hmrest.ArrayPatch), regionName, availabilityZoneName, arrayName, nil)
+			return &op, err
+		}
+	}
+}
+
+// computedRefSchema describes a reference to another Fusion resource, as a single element list
+func computedRefSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"kind": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"self_link": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
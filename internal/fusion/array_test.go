@@ -0,0 +1,133 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Registering an array needs a real one that isn't part of Fusion yet
+const (
+	testArrayHostNameVar     = "FUSION_TEST_ARRAY_HOST_NAME"
+	testArrayApplianceIdVar  = "FUSION_TEST_ARRAY_APPLIANCE_ID"
+	testArrayHardwareTypeVar = "FUSION_TEST_ARRAY_HARDWARE_TYPE"
+)
+
+// Registers an array into a new availability zone, toggles maintenance mode and updates the display name
+func TestAccArray_basic(t *testing.T) {
+	hostName := os.Getenv(testArrayHostNameVar)
+	applianceId := os.Getenv(testArrayApplianceIdVar)
+	hardwareType := os.Getenv(testArrayHardwareTypeVar)
+	if hostName == "" || applianceId == "" || hardwareType == "" {
+		t.Skipf("%s, %s and %s must be set to register an array", testArrayHostNameVar, testArrayApplianceIdVar, testArrayHardwareTypeVar)
+	}
+
+	rNameConfig := acctest.RandomWithPrefix("array_test")
+	rName := "fusion_array." + rNameConfig
+	arrayName := acctest.RandomWithPrefix("test_array")
+	regionName := acctest.RandomWithPrefix("test_region")
+	availabilityZoneName := acctest.RandomWithPrefix("test_az")
+
+	commonConfig := testRegionConfig("region", regionName, "region display name") +
+		testAvailabilityZoneConfig("az", availabilityZoneName, "az display name", "fusion_region.region.name")
+	arrayConfig := func(displayName string, maintenanceMode bool) string {
+		return testArrayConfig(rNameConfig, arrayName, displayName, hostName, hardwareType, applianceId, maintenanceMode)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckArrayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: commonConfig + arrayConfig("array display name", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "name", arrayName),
+					resource.TestCheckResourceAttr(rName, "maintenance_mode", "true"),
+					resource.TestCheckResourceAttr(rName, "hardware_type_ref.0.name", hardwareType),
+					resource.TestCheckResourceAttr(rName, "region_ref.0.name", regionName),
+					testArrayExists(rName),
+				),
+			},
+			{
+				Config: commonConfig + arrayConfig("array display name 2", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "display_name", "array display name 2"),
+					resource.TestCheckResourceAttr(rName, "maintenance_mode", "false"),
+					testArrayExists(rName),
+				),
+			},
+		},
+	})
+}
+
+func testArrayExists(rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfArray, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rName)
+		}
+		if tfArray.Type != "fusion_array" {
+			return fmt.Errorf("expected type: fusion_array. Found: %s", tfArray.Type)
+		}
+		attrs := tfArray.Primary.Attributes
+
+		goclientArray, _, err := testAccProvider.Meta().(*hmrest.APIClient).ArraysApi.GetArray(context.Background(),
+			attrs["region_name"], attrs["availability_zone_name"], attrs["name"], nil)
+		if err != nil {
+			return fmt.Errorf("go client returned error while searching for %s. Error: %s", attrs["name"], err)
+		}
+		if goclientArray.Name != attrs["name"] || goclientArray.DisplayName != attrs["display_name"] ||
+			fmt.Sprint(goclientArray.MaintenanceMode) != attrs["maintenance_mode"] {
+			return fmt.Errorf("terraform array doesnt match goclients array")
+		}
+		return nil
+	}
+}
+
+func testCheckArrayDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*hmrest.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fusion_array" {
+			continue
+		}
+		attrs := rs.Primary.Attributes
+
+		_, resp, err := client.ArraysApi.GetArray(context.Background(), attrs["region_name"], attrs["availability_zone_name"], attrs["name"], nil)
+		if err != nil && resp.StatusCode == http.StatusNotFound {
+			continue
+		} else {
+			return fmt.Errorf("array may still exist. Expected response code 404, got code %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+func testArrayConfig(rName, arrayName, displayName, hostName, hardwareType, applianceId string, maintenanceMode bool) string {
+	return fmt.Sprintf(`
+	resource "fusion_array" "%[1]s" {
+		name                   = "%[2]s"
+		display_name           = "%[3]s"
+		region_name            = fusion_availability_zone.az.region_name
+		availability_zone_name = fusion_availability_zone.az.name
+		host_name              = "%[4]s"
+		hardware_type          = "%[5]s"
+		appliance_id           = "%[6]s"
+		maintenance_mode       = %[7]t
+	}
+	`, rName, arrayName, displayName, hostName, hardwareType, applianceId, maintenanceMode)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	context "context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+var availabilityZoneResourceFunctions *BaseResourceFunctions
+
+// Implements ResourceProvider
+type availabilityZoneProvider struct {
+	BaseResourceProvider
+}
+
+// This is our entry point for the Availability Zone resource. Get it movin'
+func resourceAvailabilityZone() *schema.Resource {
+	vp := &availabilityZoneProvider{BaseResourceProvider{ResourceKind: "AvailabilityZone"}}
+	availabilityZoneResourceFunctions = NewBaseResourceFunctions("AvailabilityZone", vp)
+
+	availabilityZoneResourceFunctions.Resource.Schema = map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"region_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+	}
+	// The API has no way to patch an availability zone, every change is a replacement.
+	availabilityZoneResourceFunctions.Resource.UpdateContext = nil
+	availabilityZoneResourceFunctions.Resource.Importer = &schema.ResourceImporter{
+		StateContext: importAvailabilityZone,
+	}
+
+	return availabilityZoneResourceFunctions.Resource
+}
+
+func (vp *availabilityZoneProvider) PrepareCreate(ctx context.Context, d *schema.ResourceData) (InvokeWriteAPI, ResourcePost, error) {
+	name := rdString(ctx, d, "name")
+	displayName := rdStringDefault(ctx, d, "display_name", name)
+	regionName := rdString(ctx, d, "region_name")
+
+	body := hmrest.AvailabilityZonePost{
+		Name:        name,
+		DisplayName: displayName,
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.AvailabilityZonesApi.CreateAvailabilityZone(ctx, *body.(*hmrest.AvailabilityZonePost), regionName, nil)
+		return &op, err
+	}
+	return fn, &body, nil
+}
+
+func (vp *availabilityZoneProvider) ReadResource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	az, _, err := client.AvailabilityZonesApi.GetAvailabilityZoneById(ctx, d.Id(), nil)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", az.Name)
+	d.Set("display_name", az.DisplayName)
+	d.Set("region_name", az.Region.Name)
+	return nil
+}
+
+func (vp *availabilityZoneProvider) PrepareDelete(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, error) {
+	availabilityZoneName := rdString(ctx, d, "name")
+	regionName := rdString(ctx, d, "region_name")
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.AvailabilityZonesApi.DeleteAvailabilityZone(ctx, regionName, availabilityZoneName, nil)
+		return &op, err
+	}
+	return fn, nil
+}
+
+// importAvailabilityZone imports an availability zone by its "<region name>/<availability zone name>" path.
+func importAvailabilityZone(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("availability zone must be imported as <region name>/<availability zone name>, got %q", d.Id())
+	}
+
+	client := m.(*hmrest.APIClient)
+	az, _, err := client.AvailabilityZonesApi.GetAvailabilityZone(ctx, parts[0], parts[1], nil)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(az.Id)
+	return availabilityZoneResourceFunctions.resourceImport(ctx, d, m)
+}
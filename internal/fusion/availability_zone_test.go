@@ -0,0 +1,121 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Creates, imports by path and destroys
+func TestAccAvailabilityZone_basic(t *testing.T) {
+	rNameConfig := acctest.RandomWithPrefix("availability_zone_test")
+	rName := "fusion_availability_zone." + rNameConfig
+	displayName := acctest.RandomWithPrefix("az-display-name")
+	regionName := acctest.RandomWithPrefix("test_region")
+	availabilityZoneName := acctest.RandomWithPrefix("test_az")
+
+	regionConfig := testRegionConfig("region", regionName, "region display name")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckAvailabilityZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: regionConfig + testAvailabilityZoneConfig(rNameConfig, availabilityZoneName, displayName, "fusion_region.region.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "name", availabilityZoneName),
+					resource.TestCheckResourceAttr(rName, "display_name", displayName),
+					resource.TestCheckResourceAttr(rName, "region_name", regionName),
+					testAvailabilityZoneExists(rName),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateId:     regionName + "/" + availabilityZoneName,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  rName,
+				ImportState:   true,
+				ImportStateId: availabilityZoneName,
+				ExpectError:   regexp.MustCompile("must be imported as <region name>/<availability zone name>"),
+			},
+			// Changing the display name replaces the availability zone
+			{
+				Config: regionConfig + testAvailabilityZoneConfig(rNameConfig, availabilityZoneName, displayName+"-new", "fusion_region.region.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "display_name", displayName+"-new"),
+					testAvailabilityZoneExists(rName),
+				),
+			},
+		},
+	})
+}
+
+func testAvailabilityZoneExists(rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfAvailabilityZone, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rName)
+		}
+		if tfAvailabilityZone.Type != "fusion_availability_zone" {
+			return fmt.Errorf("expected type: fusion_availability_zone. Found: %s", tfAvailabilityZone.Type)
+		}
+		attrs := tfAvailabilityZone.Primary.Attributes
+
+		goclientAvailabilityZone, _, err := testAccProvider.Meta().(*hmrest.APIClient).AvailabilityZonesApi.GetAvailabilityZone(context.Background(),
+			attrs["region_name"], attrs["name"], nil)
+		if err != nil {
+			return fmt.Errorf("go client returned error while searching for %s. Error: %s", attrs["name"], err)
+		}
+		if goclientAvailabilityZone.Name != attrs["name"] || goclientAvailabilityZone.DisplayName != attrs["display_name"] {
+			return fmt.Errorf("terraform availability zone doesnt match goclients availability zone")
+		}
+		return nil
+	}
+}
+
+func testCheckAvailabilityZoneDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*hmrest.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fusion_availability_zone" {
+			continue
+		}
+		attrs := rs.Primary.Attributes
+
+		_, resp, err := client.AvailabilityZonesApi.GetAvailabilityZone(context.Background(), attrs["region_name"], attrs["name"], nil)
+		if err != nil && resp.StatusCode == http.StatusNotFound {
+			continue
+		} else {
+			return fmt.Errorf("availability zone may still exist. Expected response code 404, got code %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+// region is an HCL expression
+func testAvailabilityZoneConfig(rName, availabilityZoneName, displayName, region string) string {
+	return fmt.Sprintf(`
+	resource "fusion_availability_zone" "%[1]s" {
+		name          = "%[2]s"
+		display_name  = "%[3]s"
+		region_name   = %[4]s
+	}
+	`, rName, availabilityZoneName, displayName, region)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+	"github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/utilities"
+)
+
+// This is what you need to implement as the owner of a data source. Use the BaseDataSourceFunctions to build a schema.
+type DataSourceProvider interface {
+	// ReadDataSource synchronously looks up the data source via its REST API. It must set the id.
+	ReadDataSource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (err error)
+}
+
+// Implements interface to Terraform: data source read
+type BaseDataSourceFunctions struct {
+	*schema.Resource
+	DataSourceKind string
+	Provider       DataSourceProvider
+}
+
+func NewBaseDataSourceFunctions(dataSourceKind string, provider DataSourceProvider) *BaseDataSourceFunctions {
+	result := &BaseDataSourceFunctions{&schema.Resource{}, dataSourceKind, provider}
+	result.Resource.ReadContext = result.dataSourceRead
+	return result
+}
+
+func (f *BaseDataSourceFunctions) dataSourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ctx = tflog.With(ctx, "data_source_kind", f.DataSourceKind)
+	tflog.Debug(ctx, "data source", "action", "Read")
+
+	client := m.(*hmrest.APIClient)
+	err := f.Provider.ReadDataSource(ctx, client, d)
+	return utilities.ProcessClientError(ctx, "read", err)
+}
+
+// How many items are requested at once while paging through a list
+const listPageSize = 100
+
+// listAllPages calls listPage with increasing offsets until there are no more items, or until limit items were
+// listed when limit isn't 0. listPage returns how many items it got and whether more items remain.
+func listAllPages(ctx context.Context, offset, limit int, listPage func(offset, pageSize int32) (int, bool, error)) error {
+	listed := 0
+	for {
+		pageSize := listPageSize
+		if remaining := limit - listed; limit > 0 && remaining < listPageSize {
+			pageSize = remaining
+		}
+
+		count, moreItemsRemaining, err := listPage(int32(offset), int32(pageSize))
+		if err != nil {
+			return err
+		}
+		tflog.Debug(ctx, "listed page", "offset", offset, "count", count, "more_items_remaining", moreItemsRemaining)
+		listed += count
+		offset += count
+
+		if !moreItemsRemaining || count == 0 || (limit > 0 && listed >= limit) {
+			return nil
+		}
+	}
+}
+
+// dataSourceQueryId identifies the results of a query by its parameters
+func dataSourceQueryId(params ...interface{}) string {
+	return fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(fmt.Sprintf("%+v", params))))
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListAllPages(t *testing.T) {
+	const total = 250
+	listPage := func(listed *[]int) func(offset, pageSize int32) (int, bool, error) {
+		return func(offset, pageSize int32) (int, bool, error) {
+			if pageSize > listPageSize {
+				t.Fatalf("requested %d items, more than the page size", pageSize)
+			}
+			count := 0
+			for i := int(offset); i < total && count < int(pageSize); i++ {
+				*listed = append(*listed, i)
+				count++
+			}
+			return count, int(offset)+count < total, nil
+		}
+	}
+
+	tests := []struct {
+		offset, limit int
+		expectedCount int
+		expectedFirst int
+	}{
+		{0, 0, 250, 0},
+		{10, 0, 240, 10},
+		{0, 120, 120, 0},
+		{200, 100, 50, 200},
+		{300, 0, 0, 0},
+	}
+	for _, test := range tests {
+		var listed []int
+		if err := listAllPages(context.Background(), test.offset, test.limit, listPage(&listed)); err != nil {
+			t.Fatal(err)
+		}
+		if len(listed) != test.expectedCount {
+			t.Errorf("offset %d limit %d: listed %d items, expected %d", test.offset, test.limit, len(listed), test.expectedCount)
+		}
+		if len(listed) > 0 && listed[0] != test.expectedFirst {
+			t.Errorf("offset %d limit %d: first item is %d, expected %d", test.offset, test.limit, listed[0], test.expectedFirst)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Fusion accepts a subset of ISO 8601 durations: capital designators and whole numbers only.
+// Years and months are left out because they don't have a fixed length and so can't be compared.
+var isoDurationRegexp = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// parseDuration converts an ISO 8601 duration such as "PT1H" or "P1DT12H" into seconds.
+func parseDuration(s string) (int64, error) {
+	m := isoDurationRegexp.FindStringSubmatch(s)
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		hint := ""
+		if strings.ToUpper(s) != s {
+			hint = " (designators must be capital letters)"
+		}
+		return 0, fmt.Errorf("%q is not a supported ISO 8601 duration%s, expected something like PT1H or P1DT12H", s, hint)
+	}
+
+	var seconds int64
+	for i, unit := range []int64{24 * 60 * 60, 60 * 60, 60, 1} {
+		if m[i+1] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(m[i+1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q: %w", s, err)
+		}
+		seconds += n * unit
+	}
+	if seconds <= 0 {
+		return 0, fmt.Errorf("%q must be longer than zero", s)
+	}
+	return seconds, nil
+}
+
+func validateDuration(val interface{}, p cty.Path) diag.Diagnostics {
+	if _, err := parseDuration(val.(string)); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid duration",
+			Detail:        err.Error(),
+			AttributePath: p,
+		}}
+	}
+	return nil
+}
+
+// suppressEquivalentDurations hides diffs between e.g. "PT60M" and "PT1H".
+func suppressEquivalentDurations(k, old, new string, d *schema.ResourceData) bool {
+	oldValue, err := parseDuration(old)
+	if err != nil {
+		return false
+	}
+	newValue, err := parseDuration(new)
+	if err != nil {
+		return false
+	}
+	return oldValue == newValue
+}
+
+// keepEquivalentDuration returns the configured spelling when it means the same as the value read back from Fusion.
+func keepEquivalentDuration(configured, read string) string {
+	configuredValue, err := parseDuration(configured)
+	if err != nil {
+		return read
+	}
+	readValue, err := parseDuration(read)
+	if err != nil || readValue != configuredValue {
+		return read
+	}
+	return configured
+}
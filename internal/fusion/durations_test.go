@@ -0,0 +1,52 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"testing"
+)
+
+func TestParseDuration(t *testing.T) {
+	cases := map[string]int64{
+		"PT1H":     60 * 60,
+		"PT60M":    60 * 60,
+		"PT3600S":  60 * 60,
+		"P1D":      24 * 60 * 60,
+		"PT24H":    24 * 60 * 60,
+		"P1DT12H":  36 * 60 * 60,
+		"PT1H30M":  90 * 60,
+		"PT05M":    5 * 60,
+		"P7DT1M1S": 7*24*60*60 + 61,
+	}
+	for input, expected := range cases {
+		got, err := parseDuration(input)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", input, err)
+		} else if got != expected {
+			t.Errorf("%q: expected %d, got %d", input, expected, got)
+		}
+	}
+}
+
+func TestParseDurationInvalid(t *testing.T) {
+	for _, input := range []string{"", "P", "PT", "P1DT", "pt1h", "PT1.5H", "P1Y", "P1M", "PT1H1D", "1H", "PT0S"} {
+		if got, err := parseDuration(input); err == nil {
+			t.Errorf("%q: expected error, got %d", input, got)
+		}
+	}
+}
+
+func TestKeepEquivalentDuration(t *testing.T) {
+	if got := keepEquivalentDuration("PT60M", "PT1H"); got != "PT60M" {
+		t.Errorf("expected configured spelling to be kept, got %q", got)
+	}
+	if got := keepEquivalentDuration("PT30M", "PT1H"); got != "PT1H" {
+		t.Errorf("expected value read from Fusion, got %q", got)
+	}
+	if got := keepEquivalentDuration("", "PT1H"); got != "PT1H" {
+		t.Errorf("expected value read from Fusion on import, got %q", got)
+	}
+}
@@ -7,10 +7,18 @@ package fusion
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strings"
 
-	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
-
+	"github.com/antihax/optional"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+	"github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/utilities"
 )
 
 var hostAccessPolicyResourceFunctions *BaseResourceFunctions
@@ -22,26 +30,102 @@ func resourceHostAccessPolicy() *schema.Resource {
 
 	hostAccessPolicyResourceFunctions.Resource.Schema = map[string]*schema.Schema{
 		"name": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: hostAccessPolicyRecreateNote,
 		},
 		"display_name": {
-			Type:     schema.TypeString,
-			Optional: true,
-			Computed: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: hostAccessPolicyRecreateNote,
 		},
 		"iqn": {
 			Type:     schema.TypeString,
 			Required: true,
+			Description: "iSCSI name of the host in one of the RFC 3720 formats: `iqn.yyyy-mm.<reversed domain>[:<identifier>]`, " +
+				"`eui.<16 hex digits>` or `naa.<16 or 32 hex digits>`. " + hostAccessPolicyRecreateNote,
+			ValidateDiagFunc: validateIqn,
+			StateFunc:        func(v interface{}) string { return normalizeIqn(v.(string)) },
 		},
 		"personality": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      "One of " + strings.Join(hostPersonalities, ", ") + ". " + hostAccessPolicyRecreateNote,
+			ValidateDiagFunc: validatePersonality,
+			StateFunc:        func(v interface{}) string { return strings.ToLower(v.(string)) },
 		},
 	}
+
 	return hostAccessPolicyResourceFunctions.Resource
 }
 
+// The API can't modify host access policies, updating one deletes and creates it again, see PrepareUpdate
+const hostAccessPolicyRecreateNote = "Changing this value re-creates the policy, the volumes using it are moved over to the new one"
+
+// Personalities supported by Fusion, along with those announced as coming
+var hostPersonalities = []string{"windows", "linux", "esxi", "oracle-vm-server", "aix", "hitachi-vsp", "hpux", "solaris", "vms"}
+
+var (
+	iqnRegexp = regexp.MustCompile(`^iqn\.\d{4}-(0[1-9]|1[0-2])\.[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*(:.+)?$`)
+	euiRegexp = regexp.MustCompile(`^eui\.[0-9a-f]{16}$`)
+	naaRegexp = regexp.MustCompile(`^naa\.([0-9a-f]{16}|[0-9a-f]{32})$`)
+)
+
+// normalizeIqn lowercases iSCSI names, which are case insensitive (RFC 3722)
+func normalizeIqn(iqn string) string {
+	return strings.ToLower(strings.TrimSpace(iqn))
+}
+
+func validateIqn(val interface{}, p cty.Path) diag.Diagnostics {
+	iqn := normalizeIqn(val.(string))
+	switch {
+	case iqn == "":
+		return hostAccessPolicyDiag(p, "iqn must be specified", "")
+	case strings.HasPrefix(iqn, "iqn."):
+		if !iqnRegexp.MatchString(iqn) {
+			return hostAccessPolicyDiag(p, "Invalid iqn", fmt.Sprintf("%q is not a valid iqn. name, expected "+
+				"iqn.yyyy-mm.<reversed domain>[:<identifier>], e.g. iqn.2022-05.com.example:host1", val))
+		}
+	case strings.HasPrefix(iqn, "eui."):
+		if !euiRegexp.MatchString(iqn) {
+			return hostAccessPolicyDiag(p, "Invalid iqn", fmt.Sprintf("%q is not a valid eui. name, expected "+
+				"eui. followed by 16 hex digits, e.g. eui.02004567a425678d", val))
+		}
+	case strings.HasPrefix(iqn, "naa."):
+		if !naaRegexp.MatchString(iqn) {
+			return hostAccessPolicyDiag(p, "Invalid iqn", fmt.Sprintf("%q is not a valid naa. name, expected "+
+				"naa. followed by 16 or 32 hex digits, e.g. naa.52004567ba64678d", val))
+		}
+	default:
+		return hostAccessPolicyDiag(p, "Invalid iqn", fmt.Sprintf("%q must start with iqn., eui. or naa.", val))
+	}
+	return nil
+}
+
+func validatePersonality(val interface{}, p cty.Path) diag.Diagnostics {
+	personality := strings.ToLower(val.(string))
+	if personality == "" {
+		return hostAccessPolicyDiag(p, "personality must be specified", "")
+	}
+	for _, supported := range hostPersonalities {
+		if personality == supported {
+			return nil
+		}
+	}
+	return hostAccessPolicyDiag(p, "Invalid personality",
+		fmt.Sprintf("%q is not supported, must be one of: %s", val, strings.Join(hostPersonalities, ", ")))
+}
+
+func hostAccessPolicyDiag(p cty.Path, summary, detail string) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       summary,
+		Detail:        detail,
+		AttributePath: p,
+	}}
+}
+
 // Implements ResourceProvider
 type hostAccessPolicyProvider struct {
 	BaseResourceProvider
@@ -75,18 +159,123 @@ func (vp *hostAccessPolicyProvider) ReadResource(ctx context.Context, client *hm
 
 	d.Set("name", hap.Name)
 	d.Set("display_name", hap.DisplayName)
-	d.Set("iqn", hap.Iqn)
-	d.Set("personality", hap.Personality)
+	d.Set("iqn", normalizeIqn(hap.Iqn))
+	d.Set("personality", strings.ToLower(hap.Personality))
 
 	return nil
 }
 
+// hostAccessPolicyProvider.PrepareUpdate re-creates the policy, which the API can't modify, in a single apply:
+// the volumes using it are detached from it, it is deleted and created again, then the volumes are attached to
+// the new policy. Hosts only lose access for the duration of the update.
+func (vp *hostAccessPolicyProvider) PrepareUpdate(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, []ResourcePatch, error) {
+	var patches []ResourcePatch // *hostAccessPolicyVolumePatch, the name of the policy to delete or *hmrest.HostAccessPoliciesPost
+
+	oldName, newName := d.GetChange("name")
+	volumes, err := hostAccessPolicyVolumes(ctx, client, d.Id())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var reattach []ResourcePatch
+	for _, vol := range volumes {
+		var detached, attached []string
+		for _, hap := range vol.HostAccessPolicies {
+			if hap.Name != oldName.(string) {
+				detached = append(detached, hap.Name)
+			}
+		}
+		attached = append(attached, detached...)
+		attached = append(attached, newName.(string))
+
+		tflog.Info(ctx, "Moving volume to the re-created host access policy", "volume_name", vol.Name)
+		patches = append(patches, &hostAccessPolicyVolumePatch{vol.Tenant.Name, vol.TenantSpace.Name, vol.Name, strings.Join(detached, ",")})
+		reattach = append(reattach, &hostAccessPolicyVolumePatch{vol.Tenant.Name, vol.TenantSpace.Name, vol.Name, strings.Join(attached, ",")})
+	}
+
+	patches = append(patches, oldName.(string), &hmrest.HostAccessPoliciesPost{
+		Name:        newName.(string),
+		DisplayName: rdString(ctx, d, "display_name"),
+		Iqn:         rdString(ctx, d, "iqn"),
+		Personality: rdString(ctx, d, "personality"),
+	})
+	patches = append(patches, reattach...)
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		switch body := body.(type) {
+		case *hostAccessPolicyVolumePatch:
+			op, _, err := client.VolumesApi.UpdateVolume(ctx, hmrest.VolumePatch{
+				HostAccessPolicies: &hmrest.NullableString{Value: body.HostNames},
+			}, body.TenantName, body.TenantSpaceName, body.VolumeName, nil)
+			return &op, err
+		case string:
+			op, _, err := client.HostAccessPoliciesApi.DeleteHostAccessPolicy(ctx, body, nil)
+			return &op, err
+		}
+
+		// The new policy has a new id, the returned operation has already completed
+		op, _, err := client.HostAccessPoliciesApi.CreateHostAccessPolicy(ctx, *body.(*hmrest.HostAccessPoliciesPost), nil)
+		if err != nil {
+			return &op, err
+		}
+		succeeded, err := utilities.WaitOnOperation(ctx, &op, client)
+		if err != nil || !succeeded {
+			return &op, err
+		}
+		d.SetId(op.Result.Resource.Id)
+		return &op, nil
+	}
+	return fn, patches, nil
+}
+
+// hostAccessPolicyVolumePatch sets the host access policies of a volume
+type hostAccessPolicyVolumePatch struct {
+	TenantName      string
+	TenantSpaceName string
+	VolumeName      string
+	HostNames       string
+}
+
+// hostAccessPolicyProvider.PrepareDelete refuses to delete a policy volumes still use, so that their hosts don't
+// lose access silently.
 func (vp *hostAccessPolicyProvider) PrepareDelete(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, error) {
 	hostAccessPolicyName := rdString(ctx, d, "name")
+	hostAccessPolicyId := d.Id()
 
 	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		volumes, err := hostAccessPolicyVolumes(ctx, client, hostAccessPolicyId)
+		if err != nil {
+			return nil, err
+		}
+		if len(volumes) > 0 {
+			volumeNames := []string{}
+			for _, vol := range volumes {
+				volumeNames = append(volumeNames, vol.Tenant.Name+"/"+vol.TenantSpace.Name+"/"+vol.Name)
+			}
+			return nil, fmt.Errorf("host access policy %s is used by volumes %s, remove it from their host_names before "+
+				"deleting it", hostAccessPolicyName, strings.Join(volumeNames, ", "))
+		}
+
 		op, _, err := client.HostAccessPoliciesApi.DeleteHostAccessPolicy(ctx, hostAccessPolicyName, nil)
 		return &op, err
 	}
 	return fn, nil
 }
+
+// hostAccessPolicyVolumes lists the volumes using a policy
+func hostAccessPolicyVolumes(ctx context.Context, client *hmrest.APIClient, hostAccessPolicyId string) ([]hmrest.Volume, error) {
+	var volumes []hmrest.Volume
+	err := listAllPages(ctx, 0, 0, func(offset, pageSize int32) (int, bool, error) {
+		page, _, err := client.VolumesApi.QueryVolumes(ctx, &hmrest.VolumesApiQueryVolumesOpts{
+			HostAccessPolicyId: optional.NewString(hostAccessPolicyId),
+			Offset:             optional.NewInt32(offset),
+			Limit:              optional.NewInt32(pageSize),
+		})
+		if err != nil {
+			return 0, false, err
+		}
+		volumes = append(volumes, page.Items...)
+		return len(page.Items), page.MoreItemsRemaining, nil
+	})
+	return volumes, err
+}
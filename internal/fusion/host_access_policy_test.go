@@ -13,6 +13,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -42,10 +43,94 @@ func TestAccHostAccessPolicy_basic(t *testing.T) {
 					testHostAccessPolicyExists(rName),
 				),
 			},
+			// Changing the display name re-creates the policy
+			{
+				Config: testHostAccessPolicyConfig(rNameConfig, hostAccessPolicyName, displayName+"-updated", iqn, "linux"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "display_name", displayName+"-updated"),
+					testHostAccessPolicyExists(rName),
+				),
+			},
+			// Changing the personality re-creates the policy
+			{
+				Config: testHostAccessPolicyConfig(rNameConfig, hostAccessPolicyName, displayName+"-updated", iqn, "esxi"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "personality", "esxi"),
+					testHostAccessPolicyExists(rName),
+				),
+			},
 		},
 	})
 }
 
+// Re-creating a policy must not take it away from the volumes using it
+func TestAccHostAccessPolicy_replaceAttachedToVolume(t *testing.T) {
+	iqn := randIQN()
+	tsName := acctest.RandomWithPrefix("ts-hapReplaceTest")
+	pgName := acctest.RandomWithPrefix("pg-hapReplaceTest")
+	scName := acctest.RandomWithPrefix("sc-hapReplaceTest")
+	volName := acctest.RandomWithPrefix("vol-hapReplaceTest")
+	hostAccessPolicyName := acctest.RandomWithPrefix("test_hap")
+	var hostAccessPolicyId string
+
+	config := func(iqn string) string {
+		return testTenantSpaceConfig("ts", "ts display name", tsName, testAccTenant) +
+			testPGConfig("", "pg", pgName, "pg display name", region_name, availability_zone_name, testAccStorageService, true) +
+			testStorageClassConfig("sc", scName, "sc display name", testAccStorageService, "1T", "100K", "1G/s") +
+			testHostAccessPolicyConfig("hap", hostAccessPolicyName, "hap display name", iqn, "linux") +
+			fmt.Sprintf(`
+	resource "fusion_volume" "vol" {
+		name                 = "%[1]s"
+		tenant_name          = fusion_tenant_space.ts.tenant_name
+		tenant_space_name    = fusion_tenant_space.ts.name
+		storage_class_name   = fusion_storage_class.sc.name
+		placement_group_name = fusion_placement_group.pg.name
+		size                 = "1G"
+		host_names           = [fusion_host_access_policy.hap.name]
+	}
+	`, volName)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckHAPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config(iqn),
+				Check: resource.ComposeTestCheckFunc(
+					testVolumeHasHostAccessPolicy(tsName, volName, hostAccessPolicyName),
+					testResourceId("fusion_host_access_policy.hap", &hostAccessPolicyId),
+				),
+			},
+			// The policy is re-created and the volume moved over to it
+			{
+				Config: config(randIQN()),
+				Check: resource.ComposeTestCheckFunc(
+					testHostAccessPolicyExists("fusion_host_access_policy.hap"),
+					testResourceReplaced("fusion_host_access_policy.hap", &hostAccessPolicyId),
+					testVolumeHasHostAccessPolicy(tsName, volName, hostAccessPolicyName),
+				),
+			},
+		},
+	})
+}
+
+func testVolumeHasHostAccessPolicy(tenantSpaceName, volumeName, hostAccessPolicyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		volume, _, err := testAccProvider.Meta().(*hmrest.APIClient).VolumesApi.GetVolume(context.Background(), testAccTenant, tenantSpaceName, volumeName, nil)
+		if err != nil {
+			return fmt.Errorf("go client returned error while searching for %s. Error: %s", volumeName, err)
+		}
+		for _, hap := range volume.HostAccessPolicies {
+			if hap.Name == hostAccessPolicyName {
+				return nil
+			}
+		}
+		return fmt.Errorf("volume %s is not attached to host access policy %s", volumeName, hostAccessPolicyName)
+	}
+}
+
 func TestAccHostAccessPolicy_RequiredAttributes(t *testing.T) {
 	rNameConfig := acctest.RandomWithPrefix("host_access_policy")
 	displayName := acctest.RandomWithPrefix("host-access-policy-display-name")
@@ -67,6 +152,14 @@ func TestAccHostAccessPolicy_RequiredAttributes(t *testing.T) {
 				Config:      testHostAccessPolicyConfig(rNameConfig, hostAccessPolicyName, displayName, iqn, ""),
 				ExpectError: regexp.MustCompile("Error: personality must be specified"),
 			},
+			{
+				Config:      testHostAccessPolicyConfig(rNameConfig, hostAccessPolicyName, displayName, "iqn.year-mo.org.debian:XX:1", "linux"),
+				ExpectError: regexp.MustCompile("is not a valid iqn. name"),
+			},
+			{
+				Config:      testHostAccessPolicyConfig(rNameConfig, hostAccessPolicyName, displayName, iqn, "linus"),
+				ExpectError: regexp.MustCompile(`"linus" is not supported`),
+			},
 		},
 	})
 }
@@ -125,5 +218,51 @@ func testCheckHAPDestroy(s *terraform.State) error {
 }
 
 func randIQN() string {
-	return fmt.Sprintf("iqn.year-mo.org.debian:XX:%d", acctest.RandIntRange(100000000000, 200000000000))
+	return fmt.Sprintf("iqn.2022-05.org.debian:xx:%d", acctest.RandIntRange(100000000000, 200000000000))
+}
+
+func TestValidateIqn(t *testing.T) {
+	valid := []string{
+		"iqn.2022-05.org.debian:01:abc",
+		"IQN.2022-05.Com.Example:Host1",
+		"iqn.1991-05.com.microsoft",
+		"eui.02004567A425678D",
+		"naa.52004567BA64678D",
+		"naa.52004567ba64678d52004567ba64678d",
+	}
+	for _, iqn := range valid {
+		if diags := validateIqn(iqn, cty.Path{}); diags.HasError() {
+			t.Errorf("%q: unexpected error: %s", iqn, diags[0].Detail)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"iqn.year-mo.org.debian:XX:1",
+		"iqn.2022-13.com.example",
+		"iqn.2022-05.-example.com",
+		"iqn.2022-05",
+		"eui.0200456",
+		"eui.02004567a425678g",
+		"naa.52004567ba64678d5200",
+		"host1",
+	}
+	for _, iqn := range invalid {
+		if diags := validateIqn(iqn, cty.Path{}); !diags.HasError() {
+			t.Errorf("%q: expected error", iqn)
+		}
+	}
+}
+
+func TestValidatePersonality(t *testing.T) {
+	for _, personality := range []string{"linux", "ESXi", "oracle-vm-server", "vms"} {
+		if diags := validatePersonality(personality, cty.Path{}); diags.HasError() {
+			t.Errorf("%q: unexpected error: %s", personality, diags[0].Detail)
+		}
+	}
+	for _, personality := range []string{"", "linus", "oracle vm server"} {
+		if diags := validatePersonality(personality, cty.Path{}); !diags.HasError() {
+			t.Errorf("%q: expected error", personality)
+		}
+	}
 }
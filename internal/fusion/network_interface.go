@@ -0,0 +1,269 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	context "context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+	"github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/utilities"
+)
+
+var networkInterfaceResourceFunctions *BaseResourceFunctions
+
+// Implements ResourceProvider
+//
+// Network interfaces are ports on an array, they can't be created or deleted. Creating the resource
+// adopts an existing port by configuring its address and group, deleting it releases the port again.
+type networkInterfaceProvider struct {
+	BaseResourceProvider
+}
+
+// This is our entry point for the Network Interface resource. Get it movin'
+func resourceNetworkInterface() *schema.Resource {
+	vp := &networkInterfaceProvider{BaseResourceProvider{ResourceKind: "NetworkInterface"}}
+	networkInterfaceResourceFunctions = NewBaseResourceFunctions("NetworkInterface", vp)
+
+	networkInterfaceResourceFunctions.Resource.Schema = map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of an existing port on the array, e.g. `ct0.eth4`",
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"region_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"availability_zone_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"array_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"address": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      "IPv4 address of the port, optionally with its prefix length, e.g. `10.21.200.5/24`",
+			ValidateDiagFunc: validateNetworkInterfaceAddress,
+		},
+		"network_interface_group_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"interface_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"services": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"max_speed": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"mac_address": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"gateway": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mtu": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"vlan": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+	networkInterfaceResourceFunctions.AddImmutableAttributes("name", "region_name", "availability_zone_name")
+
+	return networkInterfaceResourceFunctions.Resource
+}
+
+func (vp *networkInterfaceProvider) PrepareCreate(ctx context.Context, d *schema.ResourceData) (InvokeWriteAPI, ResourcePost, error) {
+	name := rdString(ctx, d, "name")
+	regionName := rdString(ctx, d, "region_name")
+	availabilityZoneName := rdString(ctx, d, "availability_zone_name")
+	arrayName := rdString(ctx, d, "array_name")
+
+	body := hmrest.NetworkInterfacePatch{
+		Eth: &hmrest.NetworkInterfacePatchEth{
+			Address: &hmrest.NullableString{Value: rdString(ctx, d, "address")},
+		},
+		NetworkInterfaceGroup: &hmrest.NullableString{Value: rdString(ctx, d, "network_interface_group_name")},
+	}
+	if displayName := rdString(ctx, d, "display_name"); displayName != "" {
+		body.DisplayName = &hmrest.NullableString{Value: displayName}
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		ni, _, err := client.NetworkInterfacesApi.GetNetworkInterface(ctx, regionName, availabilityZoneName, arrayName, name, nil)
+		if err != nil {
+			return nil, fmt.Errorf("network interface %s must already exist on array %s: %w", name, arrayName, err)
+		}
+		if ni.Eth != nil && ni.Eth.Address != "" {
+			tflog.Warn(ctx, "adopting a network interface which already has an address",
+				"network_interface_name", name, "address", ni.Eth.Address)
+		}
+
+		op, _, err := client.NetworkInterfacesApi.UpdateNetworkInterface(ctx, *body.(*hmrest.NetworkInterfacePatch),
+			regionName, availabilityZoneName, arrayName, name, nil)
+		if err != nil {
+			return &op, err
+		}
+		// The resource id is taken from the operation, make sure it refers to the adopted interface
+		succeeded, err := utilities.WaitOnOperation(ctx, &op, client)
+		if err == nil && succeeded && (op.Result == nil || op.Result.Resource == nil) {
+			op.Result = &hmrest.OperationResult{Resource: &hmrest.ResourceReference{Id: ni.Id, Name: ni.Name}}
+		}
+		return &op, err
+	}
+	return fn, &body, nil
+}
+
+func (vp *networkInterfaceProvider) ReadResource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	ni, _, err := client.NetworkInterfacesApi.GetNetworkInterfaceById(ctx, d.Id(), nil)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", ni.Name)
+	d.Set("display_name", ni.DisplayName)
+	if ni.Region != nil {
+		d.Set("region_name", ni.Region.Name)
+	}
+	if ni.AvailabilityZone != nil {
+		d.Set("availability_zone_name", ni.AvailabilityZone.Name)
+	}
+	if ni.Array != nil {
+		d.Set("array_name", ni.Array.Name)
+	}
+	if ni.NetworkInterfaceGroup != nil {
+		d.Set("network_interface_group_name", ni.NetworkInterfaceGroup.Name)
+	} else {
+		d.Set("network_interface_group_name", "")
+	}
+	d.Set("interface_type", ni.InterfaceType)
+	d.Set("enabled", ni.Enabled)
+	d.Set("services", ni.Services)
+	d.Set("max_speed", ni.MaxSpeed)
+	if ni.Eth != nil {
+		d.Set("address", keepEquivalentAddress(rdString(ctx, d, "address"), ni.Eth.Address))
+		d.Set("mac_address", ni.Eth.MacAddress)
+		d.Set("gateway", ni.Eth.Gateway)
+		d.Set("mtu", ni.Eth.Mtu)
+		d.Set("vlan", ni.Eth.Vlan)
+	}
+	return nil
+}
+
+// networkInterfaceProvider.PrepareDelete releases the port: it clears its address and group rather than deleting it.
+func (vp *networkInterfaceProvider) PrepareDelete(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, error) {
+	name := rdString(ctx, d, "name")
+	regionName := rdString(ctx, d, "region_name")
+	availabilityZoneName := rdString(ctx, d, "availability_zone_name")
+	arrayName := rdString(ctx, d, "array_name")
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.NetworkInterfacesApi.UpdateNetworkInterface(ctx, hmrest.NetworkInterfacePatch{
+			Eth: &hmrest.NetworkInterfacePatchEth{
+				Address: &hmrest.NullableString{Value: ""},
+			},
+			NetworkInterfaceGroup: &hmrest.NullableString{Value: ""},
+		}, regionName, availabilityZoneName, arrayName, name, nil)
+		return &op, err
+	}
+	return fn, nil
+}
+
+func (vp *networkInterfaceProvider) PrepareUpdate(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, []ResourcePatch, error) {
+	var patches []ResourcePatch // []*hmrest.NetworkInterfacePatch
+
+	name := rdString(ctx, d, "name")
+	regionName := rdString(ctx, d, "region_name")
+	availabilityZoneName := rdString(ctx, d, "availability_zone_name")
+	arrayName := rdString(ctx, d, "array_name")
+
+	if d.HasChange("display_name") {
+		displayName := rdString(ctx, d, "display_name")
+		tflog.Info(ctx, "Updating", "display_name", displayName)
+		patches = append(patches, &hmrest.NetworkInterfacePatch{
+			DisplayName: &hmrest.NullableString{Value: displayName},
+		})
+	}
+	// The address must fit the group's prefix, so both change together
+	if d.HasChanges("address", "network_interface_group_name") {
+		address := rdString(ctx, d, "address")
+		networkInterfaceGroupName := rdString(ctx, d, "network_interface_group_name")
+		tflog.Info(ctx, "Updating", "address", address, "network_interface_group_name", networkInterfaceGroupName)
+		patches = append(patches, &hmrest.NetworkInterfacePatch{
+			Eth: &hmrest.NetworkInterfacePatchEth{
+				Address: &hmrest.NullableString{Value: address},
+			},
+			NetworkInterfaceGroup: &hmrest.NullableString{Value: networkInterfaceGroupName},
+		})
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.NetworkInterfacesApi.UpdateNetworkInterface(ctx, *body.(*hmrest.NetworkInterfacePatch),
+			regionName, availabilityZoneName, arrayName, name, nil)
+		return &op, err
+	}
+	return fn, patches, nil
+}
+
+func validateNetworkInterfaceAddress(val interface{}, p cty.Path) diag.Diagnostics {
+	address := val.(string)
+	ip := net.ParseIP(address)
+	if strings.Contains(address, "/") {
+		ip, _, _ = net.ParseCIDR(address)
+	}
+	if ip == nil || ip.To4() == nil {
+		return networkDiag(p, fmt.Sprintf("%q is not an IPv4 address, optionally followed by a prefix length", address))
+	}
+	return nil
+}
+
+// keepEquivalentAddress keeps the configured address when Fusion reports the same one
+// with or without the prefix length.
+func keepEquivalentAddress(configured, read string) string {
+	if configured == read || read == "" || configured == "" {
+		return read
+	}
+	configuredIP, _, configuredHasLength := strings.Cut(configured, "/")
+	readIP, _, readHasLength := strings.Cut(read, "/")
+	if configuredIP == readIP && (!configuredHasLength || !readHasLength) {
+		return configured
+	}
+	return read
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	context "context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+var networkInterfaceGroupResourceFunctions *BaseResourceFunctions
+
+// Implements ResourceProvider
+type networkInterfaceGroupProvider struct {
+	BaseResourceProvider
+}
+
+// This is our entry point for the Network Interface Group resource. Get it movin'
+func resourceNetworkInterfaceGroup() *schema.Resource {
+	vp := &networkInterfaceGroupProvider{BaseResourceProvider{ResourceKind: "NetworkInterfaceGroup"}}
+	networkInterfaceGroupResourceFunctions = NewBaseResourceFunctions("NetworkInterfaceGroup", vp)
+
+	networkInterfaceGroupResourceFunctions.Resource.Schema = map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"region_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"availability_zone_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"group_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "eth",
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"eth"}, false),
+		},
+		"prefix": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      "IPv4 prefix of the network interfaces in this group, in CIDR notation, e.g. `10.21.200.0/24`",
+			ValidateDiagFunc: validateIPv4Prefix,
+		},
+		"gateway": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			Description:      "IPv4 address of the gateway, it must be inside the prefix",
+			ValidateDiagFunc: validateIPv4Address,
+		},
+		"mtu": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          1500,
+			ForceNew:         true,
+			Description:      "Between 1280 and 9216, it can't exceed the MTU of the underlying physical interfaces",
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1280, 9216)),
+		},
+		"vlan": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+	networkInterfaceGroupResourceFunctions.AddCustomizeDiff(validateNetworkInterfaceGroupGateway)
+	networkInterfaceGroupResourceFunctions.AddImmutableAttributes("name", "region_name", "availability_zone_name")
+
+	return networkInterfaceGroupResourceFunctions.Resource
+}
+
+func (vp *networkInterfaceGroupProvider) PrepareCreate(ctx context.Context, d *schema.ResourceData) (InvokeWriteAPI, ResourcePost, error) {
+	name := rdString(ctx, d, "name")
+	displayName := rdStringDefault(ctx, d, "display_name", name)
+	regionName := rdString(ctx, d, "region_name")
+	availabilityZoneName := rdString(ctx, d, "availability_zone_name")
+
+	body := hmrest.NetworkInterfaceGroupPost{
+		Name:        name,
+		DisplayName: displayName,
+		GroupType:   rdString(ctx, d, "group_type"),
+		Eth: &hmrest.NetworkInterfaceGroupEthPost{
+			Prefix:  rdString(ctx, d, "prefix"),
+			Gateway: rdString(ctx, d, "gateway"),
+			Mtu:     int32(rdInt(d, "mtu")),
+		},
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.NetworkInterfaceGroupsApi.CreateNetworkInterfaceGroup(ctx, *body.(*hmrest.NetworkInterfaceGroupPost),
+			regionName, availabilityZoneName, nil)
+		return &op, err
+	}
+	return fn, &body, nil
+}
+
+func (vp *networkInterfaceGroupProvider) ReadResource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	nig, _, err := client.NetworkInterfaceGroupsApi.GetNetworkInterfaceGroupById(ctx, d.Id(), nil)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", nig.Name)
+	d.Set("display_name", nig.DisplayName)
+	d.Set("group_type", nig.GroupType)
+	if nig.Region != nil {
+		d.Set("region_name", nig.Region.Name)
+	}
+	if nig.AvailabilityZone != nil {
+		d.Set("availability_zone_name", nig.AvailabilityZone.Name)
+	}
+	if nig.Eth != nil {
+		d.Set("prefix", nig.Eth.Prefix)
+		d.Set("gateway", nig.Eth.Gateway)
+		d.Set("mtu", nig.Eth.Mtu)
+		d.Set("vlan", nig.Eth.Vlan)
+	}
+	return nil
+}
+
+func (vp *networkInterfaceGroupProvider) PrepareDelete(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, error) {
+	networkInterfaceGroupName := rdString(ctx, d, "name")
+	regionName := rdString(ctx, d, "region_name")
+	availabilityZoneName := rdString(ctx, d, "availability_zone_name")
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.NetworkInterfaceGroupsApi.DeleteNetworkInterfaceGroup(ctx, regionName, availabilityZoneName,
+			networkInterfaceGroupName, nil)
+		return &op, err
+	}
+	return fn, nil
+}
+
+func (vp *networkInterfaceGroupProvider) PrepareUpdate(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, []ResourcePatch, error) {
+	var patches []ResourcePatch // []*hmrest.NetworkInterfaceGroupPatch
+
+	networkInterfaceGroupName := rdString(ctx, d, "name")
+	regionName := rdString(ctx, d, "region_name")
+	availabilityZoneName := rdString(ctx, d, "availability_zone_name")
+	if d.HasChange("display_name") {
+		displayName := rdString(ctx, d, "display_name")
+		tflog.Info(ctx, "Updating", "display_name", displayName)
+		patches = append(patches, &hmrest.NetworkInterfaceGroupPatch{
+			DisplayName: &hmrest.NullableString{Value: displayName},
+		})
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.NetworkInterfaceGroupsApi.UpdateNetworkInterfaceGroup(ctx, *body.(*hmrest.NetworkInterfaceGroupPatch),
+			regionName, availabilityZoneName, networkInterfaceGroupName, nil)
+		return &op, err
+	}
+	return fn, patches, nil
+}
+
+// validateNetworkInterfaceGroupGateway makes sure the gateway can be reached from the prefix.
+func validateNetworkInterfaceGroupGateway(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("prefix") || !d.NewValueKnown("gateway") {
+		return nil
+	}
+	return checkGatewayInPrefix(d.Get("gateway").(string), d.Get("prefix").(string))
+}
+
+func checkGatewayInPrefix(gateway, prefix string) error {
+	if gateway == "" {
+		return nil
+	}
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return nil // reported by the prefix validation
+	}
+	if ip := net.ParseIP(gateway); ip != nil && !network.Contains(ip) {
+		return fmt.Errorf("gateway: %s is not inside the prefix %s", gateway, prefix)
+	}
+	return nil
+}
+
+func validateIPv4Prefix(val interface{}, p cty.Path) diag.Diagnostics {
+	prefix := val.(string)
+	ip, network, err := net.ParseCIDR(prefix)
+	if err != nil || ip.To4() == nil {
+		return networkDiag(p, fmt.Sprintf("%q is not an IPv4 prefix in CIDR notation, e.g. 10.21.200.0/24", prefix))
+	}
+	if !ip.Equal(network.IP) {
+		return networkDiag(p, fmt.Sprintf("%q has host bits set, did you mean %s?", prefix, network))
+	}
+	return nil
+}
+
+func validateIPv4Address(val interface{}, p cty.Path) diag.Diagnostics {
+	address := val.(string)
+	if ip := net.ParseIP(address); ip == nil || ip.To4() == nil {
+		return networkDiag(p, fmt.Sprintf("%q is not an IPv4 address", address))
+	}
+	return nil
+}
+
+func networkDiag(p cty.Path, detail string) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       "Invalid value",
+		Detail:        detail,
+		AttributePath: p,
+	}}
+}
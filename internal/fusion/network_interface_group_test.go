@@ -0,0 +1,164 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Creates, updates the display name and destroys
+func TestAccNetworkInterfaceGroup_basic(t *testing.T) {
+	rNameConfig := acctest.RandomWithPrefix("network_interface_group_test")
+	rName := "fusion_network_interface_group." + rNameConfig
+	displayName := acctest.RandomWithPrefix("nig-display-name")
+	networkInterfaceGroupName := acctest.RandomWithPrefix("test_nig")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckNetworkInterfaceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testNetworkInterfaceGroupConfig(rNameConfig, networkInterfaceGroupName, displayName, "10.21.200.0/24", "10.21.200.1", 1500),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "name", networkInterfaceGroupName),
+					resource.TestCheckResourceAttr(rName, "display_name", displayName),
+					resource.TestCheckResourceAttr(rName, "group_type", "eth"),
+					resource.TestCheckResourceAttr(rName, "prefix", "10.21.200.0/24"),
+					resource.TestCheckResourceAttr(rName, "gateway", "10.21.200.1"),
+					resource.TestCheckResourceAttr(rName, "mtu", "1500"),
+					testNetworkInterfaceGroupExists(rName),
+				),
+			},
+			{
+				Config: testNetworkInterfaceGroupConfig(rNameConfig, networkInterfaceGroupName, displayName+"-2", "10.21.200.0/24", "10.21.200.1", 1500),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "display_name", displayName+"-2"),
+					testNetworkInterfaceGroupExists(rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkInterfaceGroup_invalid(t *testing.T) {
+	rNameConfig := acctest.RandomWithPrefix("network_interface_group_test")
+	networkInterfaceGroupName := acctest.RandomWithPrefix("test_nig")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckNetworkInterfaceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testNetworkInterfaceGroupConfig(rNameConfig, networkInterfaceGroupName, "nig", "10.21.200.0/33", "10.21.200.1", 1500),
+				ExpectError: regexp.MustCompile("is not an IPv4 prefix"),
+			},
+			{
+				Config:      testNetworkInterfaceGroupConfig(rNameConfig, networkInterfaceGroupName, "nig", "10.21.200.0/24", "10.21.201.1", 1500),
+				ExpectError: regexp.MustCompile("is not inside the prefix"),
+			},
+			{
+				Config:      testNetworkInterfaceGroupConfig(rNameConfig, networkInterfaceGroupName, "nig", "10.21.200.0/24", "10.21.200.1", 9217),
+				ExpectError: regexp.MustCompile("expected mtu to be in the range \\(1280 - 9216\\)"),
+			},
+		},
+	})
+}
+
+func TestValidateIPv4Prefix(t *testing.T) {
+	for _, prefix := range []string{"10.21.200.0/24", "192.168.0.0/16", "10.0.0.1/32"} {
+		if diags := validateIPv4Prefix(prefix, cty.Path{}); diags.HasError() {
+			t.Errorf("%q: unexpected error: %s", prefix, diags[0].Detail)
+		}
+	}
+	for _, prefix := range []string{"", "10.21.200.0", "10.21.200.0/33", "10.21.200.5/24", "fd00::/64"} {
+		if diags := validateIPv4Prefix(prefix, cty.Path{}); !diags.HasError() {
+			t.Errorf("%q: expected error", prefix)
+		}
+	}
+}
+
+func TestCheckGatewayInPrefix(t *testing.T) {
+	if err := checkGatewayInPrefix("10.21.200.1", "10.21.200.0/24"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if err := checkGatewayInPrefix("", "10.21.200.0/24"); err != nil {
+		t.Errorf("unexpected error without a gateway: %s", err)
+	}
+	if err := checkGatewayInPrefix("10.21.201.1", "10.21.200.0/24"); err == nil {
+		t.Errorf("expected error for a gateway outside of the prefix")
+	}
+}
+
+func testNetworkInterfaceGroupExists(rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfNetworkInterfaceGroup, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rName)
+		}
+		if tfNetworkInterfaceGroup.Type != "fusion_network_interface_group" {
+			return fmt.Errorf("expected type: fusion_network_interface_group. Found: %s", tfNetworkInterfaceGroup.Type)
+		}
+		attrs := tfNetworkInterfaceGroup.Primary.Attributes
+
+		goclientNetworkInterfaceGroup, _, err := testAccProvider.Meta().(*hmrest.APIClient).NetworkInterfaceGroupsApi.GetNetworkInterfaceGroup(
+			context.Background(), attrs["region_name"], attrs["availability_zone_name"], attrs["name"], nil)
+		if err != nil {
+			return fmt.Errorf("go client returned error while searching for %s. Error: %s", attrs["name"], err)
+		}
+		if goclientNetworkInterfaceGroup.Name != attrs["name"] || goclientNetworkInterfaceGroup.DisplayName != attrs["display_name"] ||
+			goclientNetworkInterfaceGroup.Eth == nil || goclientNetworkInterfaceGroup.Eth.Prefix != attrs["prefix"] {
+			return fmt.Errorf("terraform network interface group doesnt match goclients network interface group")
+		}
+		return nil
+	}
+}
+
+func testCheckNetworkInterfaceGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*hmrest.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fusion_network_interface_group" {
+			continue
+		}
+		attrs := rs.Primary.Attributes
+
+		_, resp, err := client.NetworkInterfaceGroupsApi.GetNetworkInterfaceGroup(context.Background(),
+			attrs["region_name"], attrs["availability_zone_name"], attrs["name"], nil)
+		if err != nil && resp.StatusCode == http.StatusNotFound {
+			continue
+		} else {
+			return fmt.Errorf("network interface group may still exist. Expected response code 404, got code %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+func testNetworkInterfaceGroupConfig(rName, networkInterfaceGroupName, displayName, prefix, gateway string, mtu int) string {
+	return fmt.Sprintf(`
+	resource "fusion_network_interface_group" "%[1]s" {
+		name                   = "%[2]s"
+		display_name           = "%[3]s"
+		region_name            = "%[4]s"
+		availability_zone_name = "%[5]s"
+		prefix                 = "%[6]s"
+		gateway                = "%[7]s"
+		mtu                    = %[8]d
+	}
+	`, rName, networkInterfaceGroupName, displayName, region_name, availability_zone_name, prefix, gateway, mtu)
+}
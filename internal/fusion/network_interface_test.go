@@ -0,0 +1,146 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Adopting a network interface needs an array with an unused port
+const (
+	testNetworkInterfaceArrayVar = "FUSION_TEST_NETWORK_INTERFACE_ARRAY"
+	testNetworkInterfaceNameVar  = "FUSION_TEST_NETWORK_INTERFACE_NAME"
+)
+
+// Adopts a port, moves it to another address and releases it
+func TestAccNetworkInterface_basic(t *testing.T) {
+	arrayName := os.Getenv(testNetworkInterfaceArrayVar)
+	networkInterfaceName := os.Getenv(testNetworkInterfaceNameVar)
+	if arrayName == "" || networkInterfaceName == "" {
+		t.Skipf("%s and %s must be set to adopt a network interface", testNetworkInterfaceArrayVar, testNetworkInterfaceNameVar)
+	}
+
+	rNameConfig := acctest.RandomWithPrefix("network_interface_test")
+	rName := "fusion_network_interface." + rNameConfig
+	networkInterfaceGroupName := acctest.RandomWithPrefix("test_nig")
+
+	commonConfig := testNetworkInterfaceGroupConfig("nig", networkInterfaceGroupName, "nig display name", "10.21.200.0/24", "10.21.200.1", 1500)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckNetworkInterfaceReleased(arrayName, networkInterfaceName),
+		Steps: []resource.TestStep{
+			{
+				Config: commonConfig + testNetworkInterfaceConfig(rNameConfig, networkInterfaceName, arrayName, "10.21.200.5/24"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "address", "10.21.200.5/24"),
+					resource.TestCheckResourceAttr(rName, "network_interface_group_name", networkInterfaceGroupName),
+					resource.TestCheckResourceAttrSet(rName, "mac_address"),
+					testNetworkInterfaceExists(rName),
+				),
+			},
+			{
+				Config: commonConfig + testNetworkInterfaceConfig(rNameConfig, networkInterfaceName, arrayName, "10.21.200.6/24"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "address", "10.21.200.6/24"),
+					testNetworkInterfaceExists(rName),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateNetworkInterfaceAddress(t *testing.T) {
+	for _, address := range []string{"10.21.200.5", "10.21.200.5/24"} {
+		if diags := validateNetworkInterfaceAddress(address, cty.Path{}); diags.HasError() {
+			t.Errorf("%q: unexpected error: %s", address, diags[0].Detail)
+		}
+	}
+	for _, address := range []string{"", "10.21.200", "10.21.200.5/33", "fd00::5"} {
+		if diags := validateNetworkInterfaceAddress(address, cty.Path{}); !diags.HasError() {
+			t.Errorf("%q: expected error", address)
+		}
+	}
+}
+
+func TestKeepEquivalentAddress(t *testing.T) {
+	cases := []struct{ configured, read, expected string }{
+		{"10.21.200.5", "10.21.200.5/24", "10.21.200.5"},
+		{"10.21.200.5/24", "10.21.200.5", "10.21.200.5/24"},
+		{"10.21.200.5/24", "10.21.200.5/25", "10.21.200.5/25"},
+		{"10.21.200.5", "10.21.200.6/24", "10.21.200.6/24"},
+		{"", "10.21.200.5/24", "10.21.200.5/24"},
+	}
+	for _, c := range cases {
+		if got := keepEquivalentAddress(c.configured, c.read); got != c.expected {
+			t.Errorf("configured %q, read %q: expected %q, got %q", c.configured, c.read, c.expected, got)
+		}
+	}
+}
+
+func testNetworkInterfaceExists(rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfNetworkInterface, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rName)
+		}
+		if tfNetworkInterface.Type != "fusion_network_interface" {
+			return fmt.Errorf("expected type: fusion_network_interface. Found: %s", tfNetworkInterface.Type)
+		}
+		attrs := tfNetworkInterface.Primary.Attributes
+
+		goclientNetworkInterface, _, err := testAccProvider.Meta().(*hmrest.APIClient).NetworkInterfacesApi.GetNetworkInterface(context.Background(),
+			attrs["region_name"], attrs["availability_zone_name"], attrs["array_name"], attrs["name"], nil)
+		if err != nil {
+			return fmt.Errorf("go client returned error while searching for %s. Error: %s", attrs["name"], err)
+		}
+		if goclientNetworkInterface.Eth == nil || keepEquivalentAddress(attrs["address"], goclientNetworkInterface.Eth.Address) != attrs["address"] ||
+			goclientNetworkInterface.NetworkInterfaceGroup == nil ||
+			goclientNetworkInterface.NetworkInterfaceGroup.Name != attrs["network_interface_group_name"] {
+			return fmt.Errorf("terraform network interface doesnt match goclients network interface")
+		}
+		return nil
+	}
+}
+
+// Network interfaces aren't deleted, destroying the resource clears the address
+func testCheckNetworkInterfaceReleased(arrayName, networkInterfaceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ni, _, err := testAccProvider.Meta().(*hmrest.APIClient).NetworkInterfacesApi.GetNetworkInterface(context.Background(),
+			region_name, availability_zone_name, arrayName, networkInterfaceName, nil)
+		if err != nil {
+			return fmt.Errorf("go client returned error while searching for %s. Error: %s", networkInterfaceName, err)
+		}
+		if ni.Eth != nil && ni.Eth.Address != "" {
+			return fmt.Errorf("network interface %s still has address %s", networkInterfaceName, ni.Eth.Address)
+		}
+		return nil
+	}
+}
+
+func testNetworkInterfaceConfig(rName, networkInterfaceName, arrayName, address string) string {
+	return fmt.Sprintf(`
+	resource "fusion_network_interface" "%[1]s" {
+		name                         = "%[2]s"
+		region_name                  = "%[3]s"
+		availability_zone_name       = "%[4]s"
+		array_name                   = "%[5]s"
+		address                      = "%[6]s"
+		network_interface_group_name = fusion_network_interface_group.nig.name
+	}
+	`, rName, networkInterfaceName, region_name, availability_zone_name, arrayName, address)
+}
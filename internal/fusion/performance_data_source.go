@@ -0,0 +1,76 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Implements DataSourceProvider
+type performanceDataSourceProvider struct{}
+
+// This is our entry point for the Performance data source
+func dataSourcePerformance() *schema.Resource {
+	ds := NewBaseDataSourceFunctions("Performance", &performanceDataSourceProvider{})
+
+	ds.Resource.Schema = metricsResourceSchema()
+	for key, description := range map[string]string{
+		"reads_per_sec":    "",
+		"read_latency_us":  "In microseconds.",
+		"read_bandwidth":   "In bytes per second.",
+		"writes_per_sec":   "",
+		"write_latency_us": "In microseconds.",
+		"write_bandwidth":  "In bytes per second.",
+	} {
+		ds.Resource.Schema[key] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: description,
+		}
+	}
+
+	return ds.Resource
+}
+
+func (ds *performanceDataSourceProvider) ReadDataSource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	kind := rdString(ctx, d, "resource_kind")
+	names, err := parseMetricsResourcePath(kind, rdString(ctx, d, "resource_path"))
+	if err != nil {
+		return err
+	}
+
+	var performance hmrest.Performance
+	switch kind {
+	case "array":
+		performance, _, err = client.ArraysApi.GetArrayPerformance(ctx, names[0], names[1], names[2], nil)
+	case "availability_zone":
+		performance, _, err = client.AvailabilityZonesApi.GetAvailabilityZonePerformance(ctx, names[0], names[1], nil)
+	case "tenant":
+		performance, _, err = client.TenantsApi.GetTenantPerformance(ctx, names[0], nil)
+	case "tenant_space":
+		performance, _, err = client.TenantSpacesApi.GetTenantSpacePerformance(ctx, names[0], names[1], nil)
+	case "placement_group":
+		performance, _, err = client.PlacementGroupsApi.GetPlacementGroupsPerformance(ctx, names[0], names[1], names[2], nil)
+	case "volume":
+		performance, _, err = client.VolumesApi.GetVolumePerformance(ctx, names[0], names[1], names[2], nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId(dataSourceQueryId("performance", kind, names))
+	d.Set("reads_per_sec", performance.ReadsPerSec)
+	d.Set("read_latency_us", performance.ReadLatencyUs)
+	d.Set("read_bandwidth", performance.ReadBandwidth)
+	d.Set("writes_per_sec", performance.WritesPerSec)
+	d.Set("write_latency_us", performance.WriteLatencyUs)
+	d.Set("write_bandwidth", performance.WriteBandwidth)
+	return setMetricsResource(d, performance.Resource)
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Reads the performance of a placement group and of the availability zone it is in
+func TestAccPerformanceDataSource_basic(t *testing.T) {
+	tsName := acctest.RandomWithPrefix("ts-perfDataSourceTest")
+	pgName := acctest.RandomWithPrefix("pg-perfDataSourceTest")
+
+	commonConfig := testPGConfigWithTS("", "pg", pgName, "pg display name", region_name, availability_zone_name, testAccStorageService, tsName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckPGDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: commonConfig + testMetricsDataSourceConfig("fusion_performance", "pg", "placement_group",
+					`"${fusion_placement_group.pg.tenant_name}/${fusion_placement_group.pg.tenant_space_name}/${fusion_placement_group.pg.name}"`) +
+					testMetricsDataSourceConfig("fusion_performance", "az", "availability_zone", `"`+region_name+`/`+availability_zone_name+`"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.fusion_performance.pg", "resource.0.id", "fusion_placement_group.pg", "id"),
+					// Nothing is using the new placement group
+					resource.TestCheckResourceAttr("data.fusion_performance.pg", "reads_per_sec", "0"),
+					resource.TestCheckResourceAttr("data.fusion_performance.pg", "writes_per_sec", "0"),
+					resource.TestCheckResourceAttr("data.fusion_performance.az", "resource.0.name", availability_zone_name),
+					resource.TestCheckResourceAttrSet("data.fusion_performance.az", "read_latency_us"),
+				),
+			},
+		},
+	})
+}
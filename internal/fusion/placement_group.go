@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
 )
 
@@ -61,7 +62,25 @@ func resourcePlacementGroup() *schema.Resource {
 			Description: "Before deleting placement group, snapshots within the placement group will be deleted. " +
 				"If `false` then any snapshots will need to be deleted as a separate step before removing the placement group",
 		},
+		"array_name": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"auto_place"},
+			Description: "Pin the placement group to this array, changing it moves the placement group. " +
+				"When not set, this is the array Fusion placed the placement group on.",
+		},
+		"auto_place": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"array_name"},
+			ValidateFunc:  validation.StringInSlice([]string{string(hmrest.PURE1META_PlacementEngine)}, false),
+			Description: "Set to `pure1meta` to move the placement group to the array the workload planner ranks best, " +
+				"on creation and whenever this is set.",
+		},
 	}
+	placementGroupResourceFunctions.AddCustomizeDiff(planPlacementGroupMove)
+	placementGroupResourceFunctions.AddImmutableAttributes("name", "tenant_name", "tenant_space_name", "region_name", "availability_zone_name", "storage_service_name")
 
 	return placementGroupResourceFunctions.Resource
 }
@@ -98,6 +117,37 @@ func (vp *placementGroupProvider) PrepareCreate(ctx context.Context, d *schema.R
 	return fn, &body, nil
 }
 
+// The array can't be chosen on creation, the placement group is moved right after
+func (vp *placementGroupProvider) PrepareCreatePatches(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, []ResourcePatch, error) {
+	var patches []ResourcePatch // []*hmrest.PlacementGroupPatch
+
+	name := rdString(ctx, d, "name")
+	tenantName := rdString(ctx, d, "tenant_name")
+	tenantSpaceName := rdString(ctx, d, "tenant_space_name")
+	arrayName := rdString(ctx, d, "array_name")
+
+	if autoPlace := rdString(ctx, d, "auto_place"); autoPlace != "" {
+		recommended, err := recommendedArrayName(ctx, client, tenantName, tenantSpaceName, name, autoPlace)
+		if err != nil {
+			return nil, nil, err
+		}
+		arrayName = recommended
+	}
+	if arrayName != "" {
+		pg, _, err := client.PlacementGroupsApi.GetPlacementGroup(ctx, tenantName, tenantSpaceName, name, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if pg.Array == nil || pg.Array.Name != arrayName {
+			tflog.Info(ctx, "Moving", "array_name", arrayName)
+			patches = append(patches, &hmrest.PlacementGroupPatch{
+				Array: &hmrest.NullableString{Value: arrayName},
+			})
+		}
+	}
+	return placementGroupPatchFunc(tenantName, tenantSpaceName, name), patches, nil
+}
+
 func (vp *placementGroupProvider) ReadResource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
 	tflog.Debug(ctx, "PlacementGroup.ReadResource()", "id", d.Id())
 	pg, _, err := client.PlacementGroupsApi.GetPlacementGroupById(ctx, d.Id(), nil)
@@ -111,6 +161,9 @@ func (vp *placementGroupProvider) ReadResource(ctx context.Context, client *hmre
 	d.Set("tenant_space_name", pg.TenantSpace.Name)
 	d.Set("availability_zone_name", pg.AvailabilityZone.Name)
 	d.Set("storage_service_name", pg.StorageService.Name)
+	if pg.Array != nil {
+		d.Set("array_name", pg.Array.Name)
+	}
 
 	az, _, err := client.AvailabilityZonesApi.GetAvailabilityZoneById(ctx, pg.AvailabilityZone.Id, nil)
 	if err != nil {
@@ -176,18 +229,60 @@ func (vp *placementGroupProvider) PrepareUpdate(ctx context.Context, client *hmr
 	tenantName := rdString(ctx, d, "tenant_name")
 	tenantSpaceName := rdString(ctx, d, "tenant_space_name")
 
-	if d.HasChangeExcept("display_name") {
-		return nil, nil, fmt.Errorf("attempting to update an immutable field")
-	} else if d.HasChange("display_name") {
+	if d.HasChange("display_name") {
 		displayName := d.Get("display_name").(string)
 		tflog.Info(ctx, "Updating", "display_name", displayName)
 		patches = append(patches, &hmrest.PlacementGroupPatch{
 			DisplayName: &hmrest.NullableString{Value: displayName},
 		})
 	}
-	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+
+	currentArrayName, arrayName := d.GetChange("array_name")
+	if autoPlace := rdString(ctx, d, "auto_place"); autoPlace != "" && d.HasChange("auto_place") {
+		recommended, err := recommendedArrayName(ctx, client, tenantName, tenantSpaceName, placementGroupName, autoPlace)
+		if err != nil {
+			return nil, nil, err
+		}
+		arrayName = recommended
+	}
+	if arrayName != "" && arrayName != currentArrayName {
+		tflog.Info(ctx, "Moving", "array_name", arrayName)
+		patches = append(patches, &hmrest.PlacementGroupPatch{
+			Array: &hmrest.NullableString{Value: arrayName.(string)},
+		})
+	}
+	return placementGroupPatchFunc(tenantName, tenantSpaceName, placementGroupName), patches, nil
+}
+
+func placementGroupPatchFunc(tenantName, tenantSpaceName, placementGroupName string) InvokeWriteAPI {
+	return func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
 		op, _, err := client.PlacementGroupsApi.UpdatePlacementGroup(ctx, *body.(*hmrest.PlacementGroupPatch), tenantName, tenantSpaceName, placementGroupName, nil)
 		return &op, err
 	}
-	return fn, patches, nil
+}
+
+// planPlacementGroupMove leaves array_name unknown until the workload planner has picked the array
+func planPlacementGroupMove(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || d.Get("auto_place").(string) == "" || !d.HasChange("auto_place") {
+		return nil
+	}
+	return d.SetNewComputed("array_name")
+}
+
+// recommendedArrayName returns the array the workload planner ranks best for an existing placement group
+func recommendedArrayName(ctx context.Context, client *hmrest.APIClient, tenantName, tenantSpaceName, placementGroupName, engine string) (string, error) {
+	placementEngine := hmrest.PlacementEngine(engine)
+	recommendation, err := createPlacementRecommendation(ctx, client, hmrest.PlacementRecommendationPost{
+		Tenant:          tenantName,
+		TenantSpace:     tenantSpaceName,
+		PlacementGroup:  placementGroupName,
+		PlacementEngine: &placementEngine,
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(recommendation.IncludedArrays) == 0 {
+		return "", fmt.Errorf("the workload planner found no array to place placement group %s on", placementGroupName)
+	}
+	return recommendation.IncludedArrays[0].Name, nil
 }
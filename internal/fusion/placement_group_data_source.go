@@ -0,0 +1,191 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Implements DataSourceProvider
+type placementGroupDataSourceProvider struct{}
+
+// This is our entry point for the Placement Group data source
+func dataSourcePlacementGroup() *schema.Resource {
+	ds := NewBaseDataSourceFunctions("PlacementGroup", &placementGroupDataSourceProvider{})
+
+	ds.Resource.Schema = placementGroupDataSourceSchema()
+	ds.Resource.Schema["id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "name"},
+	}
+	ds.Resource.Schema["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "name"},
+		RequiredWith: []string{"tenant_name", "tenant_space_name"},
+	}
+	ds.Resource.Schema["tenant_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+	ds.Resource.Schema["tenant_space_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return ds.Resource
+}
+
+func (ds *placementGroupDataSourceProvider) ReadDataSource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	var pg hmrest.PlacementGroup
+	var err error
+	if id := rdString(ctx, d, "id"); id != "" {
+		pg, _, err = client.PlacementGroupsApi.GetPlacementGroupById(ctx, id, nil)
+	} else {
+		pg, _, err = client.PlacementGroupsApi.GetPlacementGroup(ctx, rdString(ctx, d, "tenant_name"), rdString(ctx, d, "tenant_space_name"),
+			rdString(ctx, d, "name"), nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	attrs, err := flattenPlacementGroup(ctx, client, pg, true)
+	if err != nil {
+		return err
+	}
+	d.SetId(pg.Id)
+	for key, value := range attrs {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// placementGroupDataSourceSchema describes a placement group as read by the placement group data sources, everything is computed
+func placementGroupDataSourceSchema() map[string]*schema.Schema {
+	computedString := func(description string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Computed: true, Description: description}
+	}
+
+	return map[string]*schema.Schema{
+		"id":                     computedString(""),
+		"name":                   computedString(""),
+		"display_name":           computedString(""),
+		"self_link":              computedString(""),
+		"tenant_name":            computedString(""),
+		"tenant_space_name":      computedString(""),
+		"availability_zone_name": computedString(""),
+		"storage_service_name":   computedString(""),
+		"placement_engine":       computedString(""),
+		"array_name":             computedString("The array the placement group is currently placed on."),
+		"iscsi_target_iqn":       computedString(""),
+		"iscsi_target_addresses": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Addresses of the iSCSI portals the placement group can be reached through.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"sessions": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The hosts currently logged in to the placement group.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"protocol":                 computedString(""),
+					"availability_zone_name":   computedString(""),
+					"initiator_iqn":            computedString(""),
+					"initiator_portal":         computedString(""),
+					"target_iqn":               computedString(""),
+					"target_portal":            computedString(""),
+					"target_discovery_address": computedString(""),
+				},
+			},
+		},
+	}
+}
+
+// flattenPlacementGroup returns the attributes of placementGroupDataSourceSchema, the sessions are looked up
+// with one more request when includeSessions is set, and left empty otherwise
+func flattenPlacementGroup(ctx context.Context, client *hmrest.APIClient, pg hmrest.PlacementGroup, includeSessions bool) (map[string]interface{}, error) {
+	attrs := map[string]interface{}{
+		"id":                     pg.Id,
+		"name":                   pg.Name,
+		"display_name":           pg.DisplayName,
+		"self_link":              pg.SelfLink,
+		"tenant_name":            "",
+		"tenant_space_name":      "",
+		"availability_zone_name": "",
+		"storage_service_name":   "",
+		"placement_engine":       "",
+		"array_name":             "",
+		"iscsi_target_iqn":       "",
+		"iscsi_target_addresses": []string{},
+	}
+	if pg.Tenant != nil {
+		attrs["tenant_name"] = pg.Tenant.Name
+	}
+	if pg.TenantSpace != nil {
+		attrs["tenant_space_name"] = pg.TenantSpace.Name
+	}
+	if pg.AvailabilityZone != nil {
+		attrs["availability_zone_name"] = pg.AvailabilityZone.Name
+	}
+	if pg.StorageService != nil {
+		attrs["storage_service_name"] = pg.StorageService.Name
+	}
+	if pg.PlacementEngine != nil {
+		attrs["placement_engine"] = string(*pg.PlacementEngine)
+	}
+	if pg.Array != nil {
+		attrs["array_name"] = pg.Array.Name
+	}
+	if pg.Protocols != nil && pg.Protocols.Iscsi != nil {
+		attrs["iscsi_target_iqn"] = pg.Protocols.Iscsi.Iqn
+		if pg.Protocols.Iscsi.Addresses != nil {
+			attrs["iscsi_target_addresses"] = pg.Protocols.Iscsi.Addresses
+		}
+	}
+
+	if !includeSessions {
+		attrs["sessions"] = []map[string]interface{}{}
+		return attrs, nil
+	}
+	sessions, _, err := client.PlacementGroupsApi.GetPlacementGroupSessions(ctx, attrs["tenant_name"].(string),
+		attrs["tenant_space_name"].(string), pg.Name, nil)
+	if err != nil {
+		return nil, err
+	}
+	items := []map[string]interface{}{}
+	for _, session := range sessions.Items {
+		item := map[string]interface{}{
+			"protocol":               session.Protocol,
+			"availability_zone_name": "",
+		}
+		if session.AvailabilityZone != nil {
+			item["availability_zone_name"] = session.AvailabilityZone.Name
+		}
+		if session.Iscsi != nil {
+			item["initiator_iqn"] = session.Iscsi.InitiatorIqn
+			item["initiator_portal"] = session.Iscsi.InitiatorPortal
+			item["target_iqn"] = session.Iscsi.TargetIqn
+			item["target_portal"] = session.Iscsi.TargetPortal
+			item["target_discovery_address"] = session.Iscsi.TargetDiscoveryAddress
+		}
+		items = append(items, item)
+	}
+	attrs["sessions"] = items
+	return attrs, nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Looks up the same placement group by name and by id
+func TestAccPlacementGroupDataSource_basic(t *testing.T) {
+	tsName := acctest.RandomWithPrefix("ts-pgDataSourceTest")
+	pgName := acctest.RandomWithPrefix("pg-pgDataSourceTest")
+
+	commonConfig := testPGConfigWithTS("", "pg", pgName, "pg display name", region_name, availability_zone_name, testAccStorageService, tsName)
+	checkPlacementGroup := func(rName string) resource.TestCheckFunc {
+		return resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrPair(rName, "id", "fusion_placement_group.pg", "id"),
+			resource.TestCheckResourceAttr(rName, "name", pgName),
+			resource.TestCheckResourceAttr(rName, "display_name", "pg display name"),
+			resource.TestCheckResourceAttr(rName, "tenant_name", testAccTenant),
+			resource.TestCheckResourceAttr(rName, "tenant_space_name", tsName),
+			resource.TestCheckResourceAttr(rName, "availability_zone_name", availability_zone_name),
+			resource.TestCheckResourceAttr(rName, "storage_service_name", testAccStorageService),
+			resource.TestCheckResourceAttrSet(rName, "array_name"),
+			resource.TestCheckResourceAttrSet(rName, "iscsi_target_iqn"),
+			// No host is logged in to a new placement group
+			resource.TestCheckResourceAttr(rName, "sessions.#", "0"),
+		)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckPGDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: commonConfig + `
+				data "fusion_placement_group" "by_name" {
+					name              = fusion_placement_group.pg.name
+					tenant_name       = fusion_placement_group.pg.tenant_name
+					tenant_space_name = fusion_placement_group.pg.tenant_space_name
+				}
+				data "fusion_placement_group" "by_id" {
+					id = fusion_placement_group.pg.id
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					checkPlacementGroup("data.fusion_placement_group.by_name"),
+					checkPlacementGroup("data.fusion_placement_group.by_id"),
+				),
+			},
+		},
+	})
+}
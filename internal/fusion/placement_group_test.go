@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
 	"regexp"
 	"strings"
 	"testing"
@@ -24,6 +25,12 @@ const (
 	availability_zone_name = "az1"
 )
 
+// Moving a placement group needs two arrays in the availability zone
+const (
+	testPlacementGroupArrayVar      = "FUSION_TEST_PLACEMENT_GROUP_ARRAY"
+	testPlacementGroupOtherArrayVar = "FUSION_TEST_PLACEMENT_GROUP_OTHER_ARRAY"
+)
+
 func TestAccPlacementGroup_basic(t *testing.T) {
 	rNameConfig := acctest.RandomWithPrefix("placementgroup")
 	rName := "fusion_placement_group." + rNameConfig
@@ -111,6 +118,75 @@ func TestAccPlacementGroup_MissingAttributes(t *testing.T) {
 		},
 	})
 }
+
+// Pins a placement group to an array, moves it to another one and lets the workload planner place it
+func TestAccPlacementGroup_arrayName(t *testing.T) {
+	arrayName := os.Getenv(testPlacementGroupArrayVar)
+	otherArrayName := os.Getenv(testPlacementGroupOtherArrayVar)
+	if arrayName == "" || otherArrayName == "" {
+		t.Skipf("%s and %s must be set to move a placement group", testPlacementGroupArrayVar, testPlacementGroupOtherArrayVar)
+	}
+
+	rNameConfig := acctest.RandomWithPrefix("placementgroup")
+	rName := "fusion_placement_group." + rNameConfig
+	placementGroupName := acctest.RandomWithPrefix("test_pg")
+	tsName := acctest.RandomWithPrefix("ts-pgtest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckPGDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPGPlacementConfig(rNameConfig, placementGroupName, tsName, fmt.Sprintf(`array_name = "%s"`, arrayName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "array_name", arrayName),
+					testPlacementGroupOnArray(rName, tsName, arrayName),
+				),
+			},
+			{
+				Config: testPGPlacementConfig(rNameConfig, placementGroupName, tsName, fmt.Sprintf(`array_name = "%s"`, otherArrayName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "array_name", otherArrayName),
+					testPlacementGroupOnArray(rName, tsName, otherArrayName),
+				),
+			},
+			{
+				Config: testPGPlacementConfig(rNameConfig, placementGroupName, tsName, `auto_place = "pure1meta"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "auto_place", "pure1meta"),
+					resource.TestCheckResourceAttrSet(rName, "array_name"),
+					testPlacementGroupExists(rName, tsName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPlacementGroup_placementConflicts(t *testing.T) {
+	rNameConfig := acctest.RandomWithPrefix("placementgroup")
+	placementGroupName := acctest.RandomWithPrefix("test_pg")
+	tsName := acctest.RandomWithPrefix("ts-pgtest")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckPGDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPGPlacementConfig(rNameConfig, placementGroupName, tsName, `
+					array_name = "array1"
+					auto_place = "pure1meta"`),
+				ExpectError: regexp.MustCompile(`"array_name": conflicts with auto_place`),
+			},
+			{
+				Config:      testPGPlacementConfig(rNameConfig, placementGroupName, tsName, `auto_place = "heuristics"`),
+				ExpectError: regexp.MustCompile(`expected auto_place to be one of \[pure1meta\]`),
+			},
+		},
+	})
+}
+
 func testPGConfig(skipAttribute string, pgName string, placementGroupName string, displayName string, regionName string, availabilityZone string, storageService string, destroySnap bool) string {
 	resourceConfiguration := fmt.Sprintf(`
 	resource "fusion_placement_group" "%[1]s" {
@@ -148,6 +224,35 @@ func testPGConfigWithTS(skipAttribute string, pgName string, placementGroupName
 	return resourceConfiguration
 }
 
+// Creates a placement group in a new tenant space with extra placement arguments
+func testPGPlacementConfig(pgName, placementGroupName, tsName, placementHCL string) string {
+	return testTenantSpaceConfig("ts", "", tsName, testAccTenant) + fmt.Sprintf(`
+	resource "fusion_placement_group" "%[1]s" {
+		name                   = "%[2]s"
+		tenant_space_name      = fusion_tenant_space.ts.name
+		tenant_name            = fusion_tenant_space.ts.tenant_name
+		region_name            = "%[3]s"
+		availability_zone_name = "%[4]s"
+		storage_service_name   = "%[5]s"
+		%[6]s
+	}
+	`, pgName, placementGroupName, region_name, availability_zone_name, testAccStorageService, placementHCL)
+}
+
+func testPlacementGroupOnArray(rName, tsName, arrayName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		attrs := s.RootModule().Resources[rName].Primary.Attributes
+		pg, _, err := testAccProvider.Meta().(*hmrest.APIClient).PlacementGroupsApi.GetPlacementGroup(context.Background(), testAccTenant, tsName, attrs["name"], nil)
+		if err != nil {
+			return err
+		}
+		if pg.Array == nil || pg.Array.Name != arrayName {
+			return fmt.Errorf("placement group %s is not on array %s", attrs["name"], arrayName)
+		}
+		return nil
+	}
+}
+
 func testPlacementGroupExists(rName string, tsName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		tfPlacementGroup, ok := s.RootModule().Resources[rName]
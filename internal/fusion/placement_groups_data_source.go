@@ -0,0 +1,179 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Implements DataSourceProvider
+type placementGroupsDataSourceProvider struct{}
+
+// This is our entry point for the Placement Groups data source
+func dataSourcePlacementGroups() *schema.Resource {
+	ds := NewBaseDataSourceFunctions("PlacementGroups", &placementGroupsDataSourceProvider{})
+
+	optionalString := func(description string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Optional: true, Description: description}
+	}
+
+	ds.Resource.Schema = map[string]*schema.Schema{
+		"tenant_name": optionalString("Only list the placement groups of this tenant, all the tenants the caller can see when not set."),
+		"tenant_space_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"tenant_name"},
+			Description:  "Only list the placement groups of this tenant space.",
+		},
+		"filter":                 optionalString("A Fusion filter expression"),
+		"sort":                   optionalString("e.g. `name:desc`"),
+		"name":                   optionalString(""),
+		"display_name":           optionalString(""),
+		"array_id":               optionalString(""),
+		"iqn":                    optionalString(""),
+		"storage_service_id":     optionalString(""),
+		"availability_zone_id":   optionalString(""),
+		"placement_engine":       optionalString(""),
+		"region_name":            optionalString(""),
+		"availability_zone_name": optionalString(""),
+		"array_name":             optionalString(""),
+		"offset": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			Description:      "Skip this many placement groups.",
+		},
+		"limit": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			Description:      "Return at most this many placement groups, all of them when not set.",
+		},
+		"include_sessions": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Look up the sessions of every placement group, this is one more request per placement group.",
+		},
+		"placement_groups": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: placementGroupDataSourceSchema(),
+			},
+		},
+	}
+
+	return ds.Resource
+}
+
+func (ds *placementGroupsDataSourceProvider) ReadDataSource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	tenantName := rdString(ctx, d, "tenant_name")
+	tenantSpaceName := rdString(ctx, d, "tenant_space_name")
+	opts := placementGroupsQueryOpts(ctx, d)
+	limit := rdInt(d, "limit")
+	includeSessions := d.Get("include_sessions").(bool)
+
+	// Placement groups of a single tenant space are listed, anything else is a query across tenant spaces
+	var listPage func(opts hmrest.PlacementGroupsApiQueryPlacementGroupsOpts) (hmrest.PlacementGroupList, error)
+	if tenantSpaceName != "" && !opts.RegionName.IsSet() && !opts.AvailabilityZoneName.IsSet() && !opts.ArrayName.IsSet() {
+		listPage = func(opts hmrest.PlacementGroupsApiQueryPlacementGroupsOpts) (hmrest.PlacementGroupList, error) {
+			listOpts := listPlacementGroupsOpts(opts)
+			placementGroups, _, err := client.PlacementGroupsApi.ListPlacementGroups(ctx, tenantName, tenantSpaceName, &listOpts)
+			return placementGroups, err
+		}
+	} else {
+		if tenantName != "" {
+			tenant, _, err := client.TenantsApi.GetTenant(ctx, tenantName, nil)
+			if err != nil {
+				return err
+			}
+			opts.TenantId = optional.NewString(tenant.Id)
+		}
+		if tenantSpaceName != "" {
+			tenantSpace, _, err := client.TenantSpacesApi.GetTenantSpace(ctx, tenantName, tenantSpaceName, nil)
+			if err != nil {
+				return err
+			}
+			opts.TenantSpaceId = optional.NewString(tenantSpace.Id)
+		}
+		listPage = func(opts hmrest.PlacementGroupsApiQueryPlacementGroupsOpts) (hmrest.PlacementGroupList, error) {
+			placementGroups, _, err := client.PlacementGroupsApi.QueryPlacementGroups(ctx, &opts)
+			return placementGroups, err
+		}
+	}
+
+	offset := rdInt(d, "offset")
+	id := dataSourceQueryId(tenantName, tenantSpaceName, opts, offset, limit)
+	items := []map[string]interface{}{}
+	err := listAllPages(ctx, offset, limit, func(offset, pageSize int32) (int, bool, error) {
+		opts.Offset = optional.NewInt32(offset)
+		opts.Limit = optional.NewInt32(pageSize)
+		page, err := listPage(opts)
+		if err != nil {
+			return 0, false, err
+		}
+		for _, pg := range page.Items {
+			item, err := flattenPlacementGroup(ctx, client, pg, includeSessions)
+			if err != nil {
+				return 0, false, err
+			}
+			items = append(items, item)
+		}
+		return len(page.Items), page.MoreItemsRemaining, nil
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+	return d.Set("placement_groups", items)
+}
+
+func placementGroupsQueryOpts(ctx context.Context, d *schema.ResourceData) hmrest.PlacementGroupsApiQueryPlacementGroupsOpts {
+	var opts hmrest.PlacementGroupsApiQueryPlacementGroupsOpts
+	for key, opt := range map[string]*optional.String{
+		"filter":                 &opts.Filter,
+		"sort":                   &opts.Sort,
+		"name":                   &opts.Name,
+		"display_name":           &opts.DisplayName,
+		"array_id":               &opts.ArrayId,
+		"iqn":                    &opts.Iqn,
+		"storage_service_id":     &opts.StorageServiceId,
+		"availability_zone_id":   &opts.AvailabilityZoneId,
+		"placement_engine":       &opts.PlacementEngine,
+		"region_name":            &opts.RegionName,
+		"availability_zone_name": &opts.AvailabilityZoneName,
+		"array_name":             &opts.ArrayName,
+	} {
+		if value := rdString(ctx, d, key); value != "" {
+			*opt = optional.NewString(value)
+		}
+	}
+	return opts
+}
+
+// listPlacementGroupsOpts has the same filters, minus the ones which only make sense across tenant spaces
+func listPlacementGroupsOpts(opts hmrest.PlacementGroupsApiQueryPlacementGroupsOpts) hmrest.PlacementGroupsApiListPlacementGroupsOpts {
+	return hmrest.PlacementGroupsApiListPlacementGroupsOpts{
+		Filter:             opts.Filter,
+		Sort:               opts.Sort,
+		Limit:              opts.Limit,
+		Offset:             opts.Offset,
+		Name:               opts.Name,
+		DisplayName:        opts.DisplayName,
+		ArrayId:            opts.ArrayId,
+		Iqn:                opts.Iqn,
+		StorageServiceId:   opts.StorageServiceId,
+		AvailabilityZoneId: opts.AvailabilityZoneId,
+		PlacementEngine:    opts.PlacementEngine,
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Lists the placement groups of a tenant space, and queries them across tenant spaces
+func TestAccPlacementGroupsDataSource_basic(t *testing.T) {
+	tsName := acctest.RandomWithPrefix("ts-pgsDataSourceTest")
+	pgName := acctest.RandomWithPrefix("pg-pgsDataSourceTest")
+
+	commonConfig := testPGConfigWithTS("", "pg", pgName, "pg display name", region_name, availability_zone_name, testAccStorageService, tsName) +
+		testPGConfig("", "pg2", pgName+"-2", "pg display name 2", region_name, availability_zone_name, testAccStorageService, false)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckPGDestroy,
+		Steps: []resource.TestStep{
+			// The data sources don't depend on the placement groups, create them first
+			{
+				Config: commonConfig,
+			},
+			{
+				Config: commonConfig + fmt.Sprintf(`
+				data "fusion_placement_groups" "tenant_space" {
+					tenant_name       = fusion_tenant_space.ts.tenant_name
+					tenant_space_name = fusion_tenant_space.ts.name
+					sort              = "name"
+				}
+				data "fusion_placement_groups" "limited" {
+					tenant_name       = fusion_tenant_space.ts.tenant_name
+					tenant_space_name = fusion_tenant_space.ts.name
+					sort              = "name"
+					limit             = 1
+					include_sessions  = false
+				}
+				data "fusion_placement_groups" "query" {
+					tenant_name            = fusion_tenant_space.ts.tenant_name
+					tenant_space_name      = fusion_tenant_space.ts.name
+					availability_zone_name = "%[1]s"
+					name                   = "%[2]s-2"
+				}
+				`, availability_zone_name, pgName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fusion_placement_groups.tenant_space", "placement_groups.#", "2"),
+					resource.TestCheckResourceAttr("data.fusion_placement_groups.tenant_space", "placement_groups.0.name", pgName),
+					resource.TestCheckResourceAttr("data.fusion_placement_groups.tenant_space", "placement_groups.1.name", pgName+"-2"),
+					resource.TestCheckResourceAttr("data.fusion_placement_groups.tenant_space", "placement_groups.0.sessions.#", "0"),
+					resource.TestCheckResourceAttrSet("data.fusion_placement_groups.tenant_space", "placement_groups.0.array_name"),
+					resource.TestCheckResourceAttr("data.fusion_placement_groups.limited", "placement_groups.#", "1"),
+					resource.TestCheckResourceAttr("data.fusion_placement_groups.limited", "placement_groups.0.sessions.#", "0"),
+					resource.TestCheckResourceAttr("data.fusion_placement_groups.query", "placement_groups.#", "1"),
+					resource.TestCheckResourceAttr("data.fusion_placement_groups.query", "placement_groups.0.display_name", "pg display name 2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+	"github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/utilities"
+)
+
+// How often a placement recommendation report is read back while the workload planner fills it in
+const placementRecommendationPollInterval = 2 * time.Second
+
+// Implements DataSourceProvider
+//
+// Every read requests a new placement recommendation report, Fusion deletes it once its time_remaining runs out.
+type placementRecommendationDataSourceProvider struct{}
+
+// This is our entry point for the Placement Recommendation data source
+func dataSourcePlacementRecommendation() *schema.Resource {
+	ds := NewBaseDataSourceFunctions("PlacementRecommendation", &placementRecommendationDataSourceProvider{})
+
+	computedString := func(description string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Computed: true, Description: description}
+	}
+	computedFloat := func(description string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeFloat, Computed: true, Description: description}
+	}
+
+	ds.Resource.Schema = map[string]*schema.Schema{
+		"tenant_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"tenant_space_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"placement_group_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"placement_group_name", "simulated_placement"},
+			Description:  "Recommend where to move this existing placement group.",
+		},
+		"simulated_placement": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"placement_group_name", "simulated_placement"},
+			Description:  "Recommend where to place a new placement group with these arguments.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"region_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"availability_zone_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"storage_service_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"placement_engine": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{string(hmrest.PURE1META_PlacementEngine), string(hmrest.HEURISTICS_PlacementEngine)}, false),
+			Description:  "`pure1meta` also projects the load and capacity of each array.",
+		},
+		"target_arrays": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Only consider these arrays.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"name": computedString("The name of the generated report."),
+		"time_remaining": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Milliseconds before the report is deleted.",
+		},
+		"recommended_array_name": computedString("The best ranked array, empty when no array is suitable."),
+		"included_arrays": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The arrays the placement group can be placed on, best first.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id":                                 computedString(""),
+					"name":                               computedString(""),
+					"self_link":                          computedString(""),
+					"days_to_reach_90_percent_capacity":  computedFloat("Only with the `pure1meta` placement engine."),
+					"days_to_reach_100_percent_capacity": computedFloat("Only with the `pure1meta` placement engine."),
+					"avg_perf_usage":                     computedFloat("Only with the `pure1meta` placement engine."),
+					"avg_cap_usage":                      computedFloat("Only with the `pure1meta` placement engine."),
+					"max_perf_usage":                     computedFloat("Only with the `pure1meta` placement engine."),
+					"max_cap_usage":                      computedFloat("Only with the `pure1meta` placement engine."),
+					"error":                              computedString("Only with the `pure1meta` placement engine."),
+					"warnings": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "Issues which don't prevent placing the placement group on the array, but may lower its rank.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"message":      computedString(""),
+								"warning_code": computedString(""),
+							},
+						},
+					},
+				},
+			},
+		},
+		"excluded_arrays": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The arrays the placement group can't be placed on.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id":        computedString(""),
+					"name":      computedString(""),
+					"self_link": computedString(""),
+					"reason":    computedString(""),
+				},
+			},
+		},
+	}
+
+	return ds.Resource
+}
+
+func (ds *placementRecommendationDataSourceProvider) ReadDataSource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	body := hmrest.PlacementRecommendationPost{
+		Tenant:         rdString(ctx, d, "tenant_name"),
+		TenantSpace:    rdString(ctx, d, "tenant_space_name"),
+		PlacementGroup: rdString(ctx, d, "placement_group_name"),
+	}
+	if engine := rdString(ctx, d, "placement_engine"); engine != "" {
+		placementEngine := hmrest.PlacementEngine(engine)
+		body.PlacementEngine = &placementEngine
+	}
+	if simulated := d.Get("simulated_placement").([]interface{}); len(simulated) > 0 {
+		simulatedPlacement := simulated[0].(map[string]interface{})
+		body.SimulatedPlacement = &hmrest.SimulatedPlacementPost{
+			Region:           simulatedPlacement["region_name"].(string),
+			AvailabilityZone: simulatedPlacement["availability_zone_name"].(string),
+			StorageService:   simulatedPlacement["storage_service_name"].(string),
+		}
+	}
+	for _, array := range d.Get("target_arrays").([]interface{}) {
+		body.TargetArrays = append(body.TargetArrays, array.(string))
+	}
+
+	recommendation, err := createPlacementRecommendation(ctx, client, body)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(recommendation.Id)
+	d.Set("name", recommendation.Name)
+	d.Set("time_remaining", recommendation.TimeRemaining)
+
+	recommendedArrayName := ""
+	includedArrays := []map[string]interface{}{}
+	for _, array := range recommendation.IncludedArrays {
+		if recommendedArrayName == "" {
+			recommendedArrayName = array.Name
+		}
+		includedArrays = append(includedArrays, flattenIncludedArray(array))
+	}
+	d.Set("recommended_array_name", recommendedArrayName)
+	if err := d.Set("included_arrays", includedArrays); err != nil {
+		return err
+	}
+
+	excludedArrays := []map[string]interface{}{}
+	for _, array := range recommendation.ExcludedArrays {
+		excludedArrays = append(excludedArrays, map[string]interface{}{
+			"id":        array.Id,
+			"name":      array.Name,
+			"self_link": array.SelfLink,
+			"reason":    array.Reason,
+		})
+	}
+	return d.Set("excluded_arrays", excludedArrays)
+}
+
+// createPlacementRecommendation names the report, waits for the workload planner to fill it in and reads it back
+func createPlacementRecommendation(ctx context.Context, client *hmrest.APIClient, body hmrest.PlacementRecommendationPost) (hmrest.PlacementRecommendation, error) {
+	name, err := uuid.GenerateUUID()
+	if err != nil {
+		return hmrest.PlacementRecommendation{}, err
+	}
+	body.Name = "tf-" + name
+
+	op, _, err := client.WorkloadPlannerApi.CreatePlacementRecommendation(ctx, body, nil)
+	if err != nil {
+		return hmrest.PlacementRecommendation{}, err
+	}
+	succeeded, err := utilities.WaitOnOperation(ctx, &op, client)
+	if err != nil {
+		return hmrest.PlacementRecommendation{}, err
+	}
+	if !succeeded {
+		return hmrest.PlacementRecommendation{}, fmt.Errorf("failed to create placement recommendation: %s", op.Error_.Message)
+	}
+
+	// The operation succeeds once the report exists, the workload planner may still be ranking the arrays
+	for {
+		recommendation, resp, err := client.WorkloadPlannerApi.GetPlacementRecommendation(ctx, body.Name, nil)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return hmrest.PlacementRecommendation{}, fmt.Errorf("placement recommendation %s expired before it was filled in", body.Name)
+			}
+			return hmrest.PlacementRecommendation{}, err
+		}
+		if len(recommendation.IncludedArrays) > 0 || len(recommendation.ExcludedArrays) > 0 {
+			return recommendation, nil
+		}
+		if recommendation.TimeRemaining <= placementRecommendationPollInterval.Milliseconds() {
+			return hmrest.PlacementRecommendation{}, fmt.Errorf("placement recommendation %s expired before it was filled in", body.Name)
+		}
+		tflog.Debug(ctx, "Waiting for placement recommendation", "name", body.Name, "time_remaining", recommendation.TimeRemaining)
+		select {
+		case <-ctx.Done():
+			return hmrest.PlacementRecommendation{}, ctx.Err()
+		case <-time.After(placementRecommendationPollInterval):
+		}
+	}
+}
+
+func flattenIncludedArray(array hmrest.PlacementRecommendationIncludedArray) map[string]interface{} {
+	attrs := map[string]interface{}{
+		"id":        array.Id,
+		"name":      array.Name,
+		"self_link": array.SelfLink,
+	}
+	meta := array.Pure1meta
+	if meta == nil {
+		return attrs
+	}
+
+	attrs["days_to_reach_90_percent_capacity"] = meta.DaysToReach90PercentCapacity
+	attrs["days_to_reach_100_percent_capacity"] = meta.DaysToReach100PercentCapacity
+	attrs["error"] = meta.Error_
+	if meta.Objectives != nil {
+		attrs["avg_perf_usage"] = meta.Objectives.AvgPerfUsage
+		attrs["avg_cap_usage"] = meta.Objectives.AvgCapUsage
+		attrs["max_perf_usage"] = meta.Objectives.MaxPerfUsage
+		attrs["max_cap_usage"] = meta.Objectives.MaxCapUsage
+	}
+	warnings := []map[string]interface{}{}
+	for _, warning := range meta.Warnings {
+		warnings = append(warnings, map[string]interface{}{
+			"message":      warning.Message,
+			"warning_code": warning.WarningCode,
+		})
+	}
+	attrs["warnings"] = warnings
+	return attrs
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Asks where to place a new placement group, and where to move an existing one
+func TestAccPlacementRecommendationDataSource_basic(t *testing.T) {
+	tsName := acctest.RandomWithPrefix("ts-recommendationTest")
+	pgName := acctest.RandomWithPrefix("pg-recommendationTest")
+
+	commonConfig := testPGConfigWithTS("", "pg", pgName, "pg display name", region_name, availability_zone_name, testAccStorageService, tsName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckPGDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: commonConfig + fmt.Sprintf(`
+				data "fusion_placement_recommendation" "simulated" {
+					tenant_name       = fusion_tenant_space.ts.tenant_name
+					tenant_space_name = fusion_tenant_space.ts.name
+					placement_engine  = "heuristics"
+					simulated_placement {
+						region_name            = "%[1]s"
+						availability_zone_name = "%[2]s"
+						storage_service_name   = "%[3]s"
+					}
+				}
+				data "fusion_placement_recommendation" "existing" {
+					tenant_name          = fusion_placement_group.pg.tenant_name
+					tenant_space_name    = fusion_placement_group.pg.tenant_space_name
+					placement_group_name = fusion_placement_group.pg.name
+				}
+				`, region_name, availability_zone_name, testAccStorageService),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.fusion_placement_recommendation.simulated", "name"),
+					resource.TestCheckResourceAttrSet("data.fusion_placement_recommendation.simulated", "recommended_array_name"),
+					resource.TestCheckResourceAttrPair("data.fusion_placement_recommendation.simulated", "recommended_array_name",
+						"data.fusion_placement_recommendation.simulated", "included_arrays.0.name"),
+					resource.TestCheckResourceAttrSet("data.fusion_placement_recommendation.existing", "included_arrays.#"),
+					resource.TestCheckResourceAttrSet("data.fusion_placement_recommendation.existing", "excluded_arrays.#"),
+				),
+			},
+			{
+				Config: commonConfig + `
+				data "fusion_placement_recommendation" "neither" {
+					tenant_name       = fusion_tenant_space.ts.tenant_name
+					tenant_space_name = fusion_tenant_space.ts.name
+				}
+				`,
+				ExpectError: regexp.MustCompile("one of `placement_group_name,simulated_placement` must be specified"),
+			},
+		},
+	})
+}
+
+func TestFlattenIncludedArray(t *testing.T) {
+	attrs := flattenIncludedArray(hmrest.PlacementRecommendationIncludedArray{Id: "id", Name: "array1"})
+	if attrs["name"] != "array1" || len(attrs) != 3 {
+		t.Errorf("without pure1meta only the array reference is expected, got %v", attrs)
+	}
+
+	attrs = flattenIncludedArray(hmrest.PlacementRecommendationIncludedArray{
+		Name: "array2",
+		Pure1meta: &hmrest.Pure1MetaPlacementRecommendation{
+			DaysToReach90PercentCapacity: 42.5,
+			Objectives:                   &hmrest.Pure1MetaPlacementRecommendationObjectives{MaxCapUsage: 0.7},
+			Warnings:                     []hmrest.Pure1MetaWarning{{Message: "busy", WarningCode: "W1"}},
+		},
+	})
+	if attrs["days_to_reach_90_percent_capacity"] != 42.5 || attrs["max_cap_usage"] != 0.7 {
+		t.Errorf("pure1meta projections are missing, got %v", attrs)
+	}
+	warnings := attrs["warnings"].([]map[string]interface{})
+	if len(warnings) != 1 || warnings[0]["warning_code"] != "W1" {
+		t.Errorf("unexpected warnings %v", warnings)
+	}
+}
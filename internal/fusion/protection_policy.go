@@ -0,0 +1,140 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	context "context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+var protectionPolicyResourceFunctions *BaseResourceFunctions
+
+// Implements ResourceProvider
+type protectionPolicyProvider struct {
+	BaseResourceProvider
+}
+
+// This is our entry point for the Protection Policy resource. Get it movin'
+func resourceProtectionPolicy() *schema.Resource {
+	vp := &protectionPolicyProvider{BaseResourceProvider{ResourceKind: "ProtectionPolicy"}}
+	protectionPolicyResourceFunctions = NewBaseResourceFunctions("ProtectionPolicy", vp)
+
+	protectionPolicyResourceFunctions.Resource.Schema = map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"rpo": {
+			Type:     schema.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rpo": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ForceNew:         true,
+						Description:      "Recovery Point Objective as an ISO 8601 duration, e.g. `PT1H`.",
+						ValidateDiagFunc: validateDuration,
+						DiffSuppressFunc: suppressEquivalentDurations,
+					},
+				},
+			},
+		},
+		"retention": {
+			Type:     schema.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"after": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ForceNew:         true,
+						Description:      "How long snapshots are retained, as an ISO 8601 duration, e.g. `P7D`.",
+						ValidateDiagFunc: validateDuration,
+						DiffSuppressFunc: suppressEquivalentDurations,
+					},
+				},
+			},
+		},
+	}
+	// The API has no way to patch a protection policy, every change is a replacement.
+	protectionPolicyResourceFunctions.Resource.UpdateContext = nil
+
+	return protectionPolicyResourceFunctions.Resource
+}
+
+func (vp *protectionPolicyProvider) PrepareCreate(ctx context.Context, d *schema.ResourceData) (InvokeWriteAPI, ResourcePost, error) {
+	name := rdString(ctx, d, "name")
+	displayName := rdStringDefault(ctx, d, "display_name", name)
+
+	body := hmrest.ProtectionPolicyPost{
+		Name:        name,
+		DisplayName: displayName,
+		Objectives: []hmrest.OneOfProtectionPolicyPostObjectivesItems{
+			hmrest.Rpo{Type_: "RPO", Rpo: rdString(ctx, d, "rpo.0.rpo")},
+			hmrest.Retention{Type_: "Retention", After: rdString(ctx, d, "retention.0.after")},
+		},
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.ProtectionPoliciesApi.CreateProtectionPolicy(ctx, *body.(*hmrest.ProtectionPolicyPost), nil)
+		return &op, err
+	}
+	return fn, &body, nil
+}
+
+func (vp *protectionPolicyProvider) ReadResource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	pp, _, err := client.ProtectionPoliciesApi.GetProtectionPolicyById(ctx, d.Id(), nil)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", pp.Name)
+	d.Set("display_name", pp.DisplayName)
+
+	for _, objective := range pp.Objectives {
+		switch o := objective.(type) {
+		case *hmrest.Rpo:
+			rpo := keepEquivalentDuration(rdString(ctx, d, "rpo.0.rpo"), o.Rpo)
+			if err := d.Set("rpo", []map[string]interface{}{{"rpo": rpo}}); err != nil {
+				return err
+			}
+		case *hmrest.Retention:
+			after := keepEquivalentDuration(rdString(ctx, d, "retention.0.after"), o.After)
+			if err := d.Set("retention", []map[string]interface{}{{"after": after}}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unexpected protection policy objective %T", objective)
+		}
+	}
+	return nil
+}
+
+func (vp *protectionPolicyProvider) PrepareDelete(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, error) {
+	protectionPolicyName := rdString(ctx, d, "name")
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.ProtectionPoliciesApi.DeleteProtectionPolicy(ctx, protectionPolicyName, nil)
+		return &op, err
+	}
+	return fn, nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Creates and destroys
+func TestAccProtectionPolicy_basic(t *testing.T) {
+	rNameConfig := acctest.RandomWithPrefix("protection_policy_test")
+	rName := "fusion_protection_policy." + rNameConfig
+	displayName := acctest.RandomWithPrefix("protection-policy-display-name")
+	protectionPolicyName := acctest.RandomWithPrefix("test_pp")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckProtectionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testProtectionPolicyConfig(rNameConfig, protectionPolicyName, displayName, "PT60M", "P1D"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "name", protectionPolicyName),
+					resource.TestCheckResourceAttr(rName, "display_name", displayName),
+					resource.TestCheckResourceAttr(rName, "rpo.0.rpo", "PT60M"),
+					resource.TestCheckResourceAttr(rName, "retention.0.after", "P1D"),
+					testProtectionPolicyExists(rName),
+				),
+			},
+			// Equivalent durations must not produce a diff
+			{
+				Config:   testProtectionPolicyConfig(rNameConfig, protectionPolicyName, displayName, "PT1H", "PT24H"),
+				PlanOnly: true,
+			},
+			// Changing an objective replaces the policy
+			{
+				Config: testProtectionPolicyConfig(rNameConfig, protectionPolicyName, displayName, "PT6H", "P7D"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "rpo.0.rpo", "PT6H"),
+					resource.TestCheckResourceAttr(rName, "retention.0.after", "P7D"),
+					testProtectionPolicyExists(rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProtectionPolicy_invalidDurations(t *testing.T) {
+	rNameConfig := acctest.RandomWithPrefix("protection_policy_test")
+	protectionPolicyName := acctest.RandomWithPrefix("test_pp")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckProtectionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testProtectionPolicyConfig(rNameConfig, protectionPolicyName, "pp", "pt1h", "P1D"),
+				ExpectError: regexp.MustCompile("designators must be capital letters"),
+			},
+			{
+				Config:      testProtectionPolicyConfig(rNameConfig, protectionPolicyName, "pp", "PT1H", "P1M"),
+				ExpectError: regexp.MustCompile("not a supported ISO 8601 duration"),
+			},
+		},
+	})
+}
+
+func testProtectionPolicyExists(rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfProtectionPolicy, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rName)
+		}
+		if tfProtectionPolicy.Type != "fusion_protection_policy" {
+			return fmt.Errorf("expected type: fusion_protection_policy. Found: %s", tfProtectionPolicy.Type)
+		}
+		attrs := tfProtectionPolicy.Primary.Attributes
+
+		goclientProtectionPolicy, _, err := testAccProvider.Meta().(*hmrest.APIClient).ProtectionPoliciesApi.GetProtectionPolicy(context.Background(), attrs["name"], nil)
+		if err != nil {
+			return fmt.Errorf("go client returned error while searching for %s. Error: %s", attrs["name"], err)
+		}
+		if goclientProtectionPolicy.Name != attrs["name"] || goclientProtectionPolicy.DisplayName != attrs["display_name"] {
+			return fmt.Errorf("terraform protection policy doesnt match goclients protection policy")
+		}
+		for _, objective := range goclientProtectionPolicy.Objectives {
+			var direct, attr string
+			switch o := objective.(type) {
+			case *hmrest.Rpo:
+				direct, attr = o.Rpo, attrs["rpo.0.rpo"]
+			case *hmrest.Retention:
+				direct, attr = o.After, attrs["retention.0.after"]
+			}
+			if keepEquivalentDuration(attr, direct) != attr {
+				return fmt.Errorf("objective mismatch direct:%s tf:%s", direct, attr)
+			}
+		}
+		return nil
+	}
+}
+
+func testCheckProtectionPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*hmrest.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fusion_protection_policy" {
+			continue
+		}
+		protectionPolicyName := rs.Primary.Attributes["name"]
+
+		_, resp, err := client.ProtectionPoliciesApi.GetProtectionPolicy(context.Background(), protectionPolicyName, nil)
+		if err != nil && resp.StatusCode == http.StatusNotFound {
+			continue
+		} else {
+			return fmt.Errorf("protection policy may still exist. Expected response code 404, got code %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+func testProtectionPolicyConfig(rName, protectionPolicyName, displayName, rpo, retention string) string {
+	return fmt.Sprintf(`
+	resource "fusion_protection_policy" "%[1]s" {
+		name          = "%[2]s"
+		display_name  = "%[3]s"
+		rpo {
+			rpo = "%[4]s"
+		}
+		retention {
+			after = "%[5]s"
+		}
+	}
+	`, rName, protectionPolicyName, displayName, rpo, retention)
+}
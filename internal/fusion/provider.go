@@ -59,10 +59,34 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"fusion_host_access_policy": resourceHostAccessPolicy(),
-			"fusion_placement_group":    resourcePlacementGroup(),
-			"fusion_tenant_space":       resourceTenantSpace(),
-			"fusion_volume":             resourceVolume(),
+			"fusion_api_client":              resourceApiClient(),
+			"fusion_array":                   resourceArray(),
+			"fusion_availability_zone":       resourceAvailabilityZone(),
+			"fusion_host_access_policy":      resourceHostAccessPolicy(),
+			"fusion_network_interface":       resourceNetworkInterface(),
+			"fusion_network_interface_group": resourceNetworkInterfaceGroup(),
+			"fusion_placement_group":         resourcePlacementGroup(),
+			"fusion_protection_policy":       resourceProtectionPolicy(),
+			"fusion_region":                  resourceRegion(),
+			"fusion_role_assignment":         resourceRoleAssignment(),
+			"fusion_snapshot":                resourceSnapshot(),
+			"fusion_storage_class":           resourceStorageClass(),
+			"fusion_storage_endpoint":        resourceStorageEndpoint(),
+			"fusion_storage_service":         resourceStorageService(),
+			"fusion_tenant":                  resourceTenant(),
+			"fusion_tenant_space":            resourceTenantSpace(),
+			"fusion_volume":                  resourceVolume(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"fusion_performance":              dataSourcePerformance(),
+			"fusion_placement_group":          dataSourcePlacementGroup(),
+			"fusion_placement_groups":         dataSourcePlacementGroups(),
+			"fusion_placement_recommendation": dataSourcePlacementRecommendation(),
+			"fusion_region":                   dataSourceRegion(),
+			"fusion_space":                    dataSourceSpace(),
+			"fusion_volume":                   dataSourceVolume(),
+			"fusion_volumes":                  dataSourceVolumes(),
 		},
 
 		ConfigureContextFunc: configureProvider,
@@ -0,0 +1,52 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Implements DataSourceProvider
+type regionDataSourceProvider struct{}
+
+// This is our entry point for the Region data source
+func dataSourceRegion() *schema.Resource {
+	ds := NewBaseDataSourceFunctions("Region", &regionDataSourceProvider{})
+
+	ds.Resource.Schema = map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"self_link": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	return ds.Resource
+}
+
+func (ds *regionDataSourceProvider) ReadDataSource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	region, _, err := client.RegionsApi.GetRegion(ctx, rdString(ctx, d, "name"), nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(region.Id)
+	d.Set("name", region.Name)
+	d.Set("display_name", region.DisplayName)
+	d.Set("self_link", region.SelfLink)
+	return nil
+}
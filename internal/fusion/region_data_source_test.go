@@ -0,0 +1,44 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRegionDataSource_basic(t *testing.T) {
+	displayName := acctest.RandomWithPrefix("region-display-name")
+	regionName := acctest.RandomWithPrefix("test_region")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckRegionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testRegionConfig("region", regionName, displayName) + testRegionDataSourceConfig("region", "fusion_region.region.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.fusion_region.region", "id", "fusion_region.region", "id"),
+					resource.TestCheckResourceAttr("data.fusion_region.region", "display_name", displayName),
+					resource.TestCheckResourceAttrSet("data.fusion_region.region", "self_link"),
+				),
+			},
+		},
+	})
+}
+
+// name is an HCL expression
+func testRegionDataSourceConfig(rName, name string) string {
+	return fmt.Sprintf(`
+	data "fusion_region" "%[1]s" {
+		name = %[2]s
+	}
+	`, rName, name)
+}
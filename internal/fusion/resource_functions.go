@@ -48,6 +48,13 @@ type ResourceProvider interface {
 	PrepareDelete(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (fn InvokeWriteAPI, err error)
 }
 
+// ResourceCreatePatcher is implemented by ResourceProviders whose create API can't set every argument.
+type ResourceCreatePatcher interface {
+	// PrepareCreatePatches is like PrepareUpdate, its patches are applied once the resource is created and its id saved,
+	// so that a failure leaves the resource tainted in the state instead of unknown to Terraform.
+	PrepareCreatePatches(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (fn InvokeWriteAPI, patches []ResourcePatch, err error)
+}
+
 // Actually, an empty implementation which returns "not implemented" errors. :-)
 type BaseResourceProvider struct {
 	ResourceKind string
@@ -78,14 +85,18 @@ type BaseResourceFunctions struct {
 	*schema.Resource
 	ResourceKind string // We're for volume, tenant space, storage class, etc. More likely to come.
 	Provider     ResourceProvider
+
+	customizeDiffs      []schema.CustomizeDiffFunc
+	immutableAttributes []string
 }
 
 func NewBaseResourceFunctions(resourceKind string, provider ResourceProvider) *BaseResourceFunctions {
-	result := &BaseResourceFunctions{&schema.Resource{}, resourceKind, provider}
+	result := &BaseResourceFunctions{Resource: &schema.Resource{}, ResourceKind: resourceKind, Provider: provider}
 	result.Resource.CreateContext = result.resourceCreate
 	result.Resource.ReadContext = result.resourceRead
 	result.Resource.UpdateContext = result.resourceUpdate
 	result.Resource.DeleteContext = result.resourceDelete
+	result.Resource.CustomizeDiff = result.resourceCustomizeDiff
 	result.Resource.Importer = &schema.ResourceImporter{
 		StateContext: result.resourceImport,
 	}
@@ -125,6 +136,17 @@ func (f *BaseResourceFunctions) resourceCreate(ctx context.Context, d *schema.Re
 	// succeeded!
 	tflog.Debug(ctx, "created successfully", "operation_result", op.Result)
 	d.SetId(op.Result.Resource.Id)
+
+	if patcher, ok := f.Provider.(ResourceCreatePatcher); ok {
+		callAPI, patches, err := patcher.PrepareCreatePatches(ctx, client, d)
+		if err == nil {
+			err = executePatches(ctx, callAPI, patches, client, "resourceCreate")
+		}
+		if err != nil {
+			tflog.Error(ctx, "created but failed applying the remaining arguments", "error_message", err)
+			return utilities.ProcessClientError(ctx, "create", err)
+		}
+	}
 	return f.resourceRead(ctx, d, m)
 }
 
@@ -178,6 +200,41 @@ func (f *BaseResourceFunctions) resourceDelete(ctx context.Context, d *schema.Re
 	return nil
 }
 
+// AddCustomizeDiff declares a rule validating or adjusting the plan of the resource, rules run in the order they were added.
+// Don't set Resource.CustomizeDiff directly, it would drop the replacement of immutable attributes.
+func (f *BaseResourceFunctions) AddCustomizeDiff(fn schema.CustomizeDiffFunc) {
+	f.customizeDiffs = append(f.customizeDiffs, fn)
+}
+
+// AddImmutableAttributes declares arguments which can't be updated in place, typically the ones locating the resource
+// in Fusion. Changing any of them plans the replacement of the resource instead of failing the apply.
+func (f *BaseResourceFunctions) AddImmutableAttributes(keys ...string) {
+	f.immutableAttributes = append(f.immutableAttributes, keys...)
+}
+
+// resourceCustomizeDiff plans the replacement of the resource when one of its immutable attributes changes, instead
+// of letting the update fail, then runs the rules of the ResourceProvider
+func (f *BaseResourceFunctions) resourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" {
+		for _, key := range f.immutableAttributes {
+			if !d.HasChange(key) {
+				continue
+			}
+			tflog.Debug(ctx, "Replacing the resource", "resource_kind", f.ResourceKind, "immutable_attribute", key)
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, fn := range f.customizeDiffs {
+		if err := fn(ctx, d, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func executePatches(ctx context.Context, fn InvokeWriteAPI, patches []ResourcePatch, client *hmrest.APIClient, opSource string) error {
 	// Start operations for each update
 	for i, p := range patches {
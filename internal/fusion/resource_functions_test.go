@@ -0,0 +1,128 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceCustomizeDiff_immutableAttributes(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "ts-id",
+		Attributes: map[string]string{
+			"id":           "ts-id",
+			"tenant_name":  "tenant",
+			"name":         "ts",
+			"display_name": "ts",
+		},
+	}
+
+	for _, tc := range []struct {
+		config      map[string]interface{}
+		requiresNew bool
+	}{
+		{map[string]interface{}{"tenant_name": "tenant", "name": "ts", "display_name": "renamed"}, false},
+		{map[string]interface{}{"tenant_name": "tenant", "name": "renamed", "display_name": "ts"}, true},
+		{map[string]interface{}{"tenant_name": "other", "name": "ts", "display_name": "ts"}, true},
+	} {
+		diff, err := resourceTenantSpace().Diff(context.Background(), state, terraform.NewResourceConfigRaw(tc.config), nil)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %s", tc.config, err)
+		}
+		if diff.RequiresNew() != tc.requiresNew {
+			t.Errorf("%v: expected requires new %t, got %t", tc.config, tc.requiresNew, diff.RequiresNew())
+		}
+	}
+}
+
+// Immutable attributes must be arguments of the resource, which aren't already replacing it
+func TestResourceCustomizeDiff_declaredImmutableAttributes(t *testing.T) {
+	Provider()
+	for _, f := range []*BaseResourceFunctions{
+		arrayResourceFunctions,
+		networkInterfaceResourceFunctions,
+		networkInterfaceGroupResourceFunctions,
+		placementGroupResourceFunctions,
+		regionResourceFunctions,
+		storageClassResourceFunctions,
+		storageEndpointResourceFunctions,
+		storageServiceResourceFunctions,
+		tenantResourceFunctions,
+		tenantSpaceResourceFunctions,
+		volumeResourceFunctions,
+	} {
+		if len(f.immutableAttributes) == 0 {
+			t.Errorf("%s: no immutable attributes", f.ResourceKind)
+		}
+		for _, key := range f.immutableAttributes {
+			attr, ok := f.Resource.Schema[key]
+			if !ok || !(attr.Required || attr.Optional) || attr.ForceNew {
+				t.Errorf("%s: %s must be an argument without ForceNew", f.ResourceKind, key)
+			}
+		}
+	}
+}
+
+func TestResourceCustomizeDiff_providerRules(t *testing.T) {
+	f := NewBaseResourceFunctions("Test", &BaseResourceProvider{ResourceKind: "Test"})
+	f.Resource.Schema = map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+	var ran []string
+	for _, rule := range []string{"first", "second"} {
+		rule := rule
+		f.AddCustomizeDiff(func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			ran = append(ran, rule)
+			if rule == "second" {
+				return fmt.Errorf("rejected by %s", rule)
+			}
+			return nil
+		})
+	}
+
+	state := &terraform.InstanceState{ID: "id", Attributes: map[string]string{"id": "id", "name": "old"}}
+	_, err := f.Resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "new"}), nil)
+	if err == nil || err.Error() != "rejected by second" {
+		t.Errorf("expected the error of the second rule, got %v", err)
+	}
+	if fmt.Sprint(ran) != "[first second]" {
+		t.Errorf("expected the rules to run in order, got %v", ran)
+	}
+}
+
+// testResourceId remembers the id of a resource, for testResourceReplaced to compare with in a later step
+func testResourceId(rName string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", rName)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testResourceReplaced(rName string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", rName)
+		}
+		if rs.Primary.ID == *id {
+			return fmt.Errorf("expected %s to be replaced, it still has id %s", rName, *id)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	context "context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Scopes a role can be assigned at, as listed in a role's assignable_scopes
+const (
+	roleAssignmentScopeOrganization = "organization"
+	roleAssignmentScopeTenant       = "tenant"
+	roleAssignmentScopeTenantSpace  = "tenant_space"
+)
+
+var roleAssignmentResourceFunctions *BaseResourceFunctions
+
+// Implements ResourceProvider
+type roleAssignmentProvider struct {
+	BaseResourceProvider
+}
+
+// This is our entry point for the Role Assignment resource. Get it movin'
+func resourceRoleAssignment() *schema.Resource {
+	vp := &roleAssignmentProvider{BaseResourceProvider{ResourceKind: "RoleAssignment"}}
+	roleAssignmentResourceFunctions = NewBaseResourceFunctions("RoleAssignment", vp)
+
+	roleAssignmentResourceFunctions.Resource.Schema = map[string]*schema.Schema{
+		"role_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The role to assign, e.g. `tenant-space-admin`",
+		},
+		"user_email": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"user_email", "api_client_id"},
+			Description:  "Assign the role to the user with this email address.",
+		},
+		"api_client_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"user_email", "api_client_id"},
+			Description:  "Assign the role to this API client.",
+		},
+		"tenant_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Limit the assignment to this tenant, the assignment is organization wide when not set.",
+		},
+		"tenant_space_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			RequiredWith: []string{"tenant_name"},
+			Description:  "Limit the assignment to this tenant space of the tenant.",
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"principal": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The id of the user or API client the role is assigned to.",
+		},
+		"scope_self_link": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	// Role assignments can't be modified, every change to the arguments is a replacement.
+	roleAssignmentResourceFunctions.Resource.UpdateContext = nil
+	roleAssignmentResourceFunctions.AddCustomizeDiff(validateRoleAssignmentScope)
+
+	return roleAssignmentResourceFunctions.Resource
+}
+
+func (vp *roleAssignmentProvider) PrepareCreate(ctx context.Context, d *schema.ResourceData) (InvokeWriteAPI, ResourcePost, error) {
+	roleName := rdString(ctx, d, "role_name")
+	userEmail := rdString(ctx, d, "user_email")
+	scope, _ := roleAssignmentScope(rdString(ctx, d, "tenant_name"), rdString(ctx, d, "tenant_space_name"))
+
+	body := hmrest.RoleAssignmentPost{
+		Scope:     scope,
+		Principal: rdString(ctx, d, "api_client_id"),
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		post := body.(*hmrest.RoleAssignmentPost)
+		if userEmail != "" {
+			userId, err := findUserId(ctx, client, userEmail)
+			if err != nil {
+				return nil, err
+			}
+			post.Principal = userId
+		}
+		op, _, err := client.RoleAssignmentsApi.CreateRoleAssignment(ctx, *post, roleName, nil)
+		return &op, err
+	}
+	return fn, &body, nil
+}
+
+func (vp *roleAssignmentProvider) ReadResource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	ra, _, err := client.RoleAssignmentsApi.GetRoleAssignmentById(ctx, d.Id(), nil)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", ra.Name)
+	d.Set("principal", ra.Principal)
+	// Imported assignments only know their principal, find out whether it is a user or an API client
+	if d.Get("user_email").(string) == "" && d.Get("api_client_id").(string) == "" {
+		userEmail, err := findUserEmail(ctx, client, ra.Principal)
+		if err != nil {
+			return err
+		}
+		if userEmail != "" {
+			d.Set("user_email", userEmail)
+		} else {
+			d.Set("api_client_id", ra.Principal)
+		}
+	}
+	if ra.Role != nil {
+		d.Set("role_name", ra.Role.Name)
+	}
+	if ra.Scope != nil {
+		d.Set("scope_self_link", ra.Scope.SelfLink)
+		if tenantName, tenantSpaceName, ok := parseRoleAssignmentScope(ra.Scope.SelfLink); ok {
+			d.Set("tenant_name", tenantName)
+			d.Set("tenant_space_name", tenantSpaceName)
+		}
+	}
+	return nil
+}
+
+func (vp *roleAssignmentProvider) PrepareDelete(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, error) {
+	roleName := rdString(ctx, d, "role_name")
+	roleAssignmentName := rdString(ctx, d, "name")
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.RoleAssignmentsApi.DeleteRoleAssignment(ctx, roleName, roleAssignmentName, nil)
+		return &op, err
+	}
+	return fn, nil
+}
+
+// validateRoleAssignmentScope rejects scopes which are not in the role's assignable_scopes before anything is applied.
+func validateRoleAssignmentScope(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// Only new assignments and changed scopes are checked, so that plans don't look up the role of every assignment
+	if d.Id() != "" && !d.HasChange("role_name") && !d.HasChange("tenant_name") && !d.HasChange("tenant_space_name") {
+		return nil
+	}
+	client, ok := m.(*hmrest.APIClient)
+	if !ok || client == nil || !d.NewValueKnown("role_name") {
+		return nil
+	}
+	roleName := d.Get("role_name").(string)
+
+	// An unknown name will still be set, which is all the scope depends on
+	tenantName := d.Get("tenant_name").(string)
+	if !d.NewValueKnown("tenant_name") {
+		tenantName = "unknown"
+	}
+	tenantSpaceName := d.Get("tenant_space_name").(string)
+	if !d.NewValueKnown("tenant_space_name") {
+		tenantSpaceName = "unknown"
+	}
+	_, scopeKind := roleAssignmentScope(tenantName, tenantSpaceName)
+
+	role, resp, err := client.RolesApi.GetRole(ctx, roleName, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("role_name: role %s does not exist", roleName)
+		}
+		tflog.Warn(ctx, "could not check the scope of the role assignment", "role_name", roleName, "error_message", err)
+		return nil
+	}
+	for _, assignableScope := range role.AssignableScopes {
+		if assignableScope == scopeKind {
+			return nil
+		}
+	}
+	return fmt.Errorf("role %s can't be assigned at the %s scope, it can only be assigned at: %s",
+		roleName, scopeKind, strings.Join(role.AssignableScopes, ", "))
+}
+
+// roleAssignmentScope returns the self_link of the scope and its kind, as used in assignable_scopes
+func roleAssignmentScope(tenantName, tenantSpaceName string) (string, string) {
+	switch {
+	case tenantName == "":
+		return "/", roleAssignmentScopeOrganization
+	case tenantSpaceName == "":
+		return "/tenants/" + tenantName, roleAssignmentScopeTenant
+	default:
+		return "/tenants/" + tenantName + "/tenant-spaces/" + tenantSpaceName, roleAssignmentScopeTenantSpace
+	}
+}
+
+// parseRoleAssignmentScope is the reverse of roleAssignmentScope
+func parseRoleAssignmentScope(selfLink string) (tenantName, tenantSpaceName string, ok bool) {
+	if selfLink == "/" {
+		return "", "", true
+	}
+	parts := strings.Split(strings.TrimPrefix(selfLink, "/"), "/")
+	switch {
+	case len(parts) == 2 && parts[0] == "tenants":
+		return parts[1], "", true
+	case len(parts) == 4 && parts[0] == "tenants" && parts[2] == "tenant-spaces":
+		return parts[1], parts[3], true
+	}
+	return "", "", false
+}
+
+func findUserId(ctx context.Context, client *hmrest.APIClient, email string) (string, error) {
+	users, _, err := client.IdentityManagerApi.ListUsers(ctx, &hmrest.IdentityManagerApiListUsersOpts{
+		Email: optional.NewString(email),
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			return user.Id, nil
+		}
+	}
+	return "", fmt.Errorf("no user with email %s", email)
+}
+
+// findUserEmail is the reverse of findUserId, it returns "" when no user has this id
+func findUserEmail(ctx context.Context, client *hmrest.APIClient, id string) (string, error) {
+	users, _, err := client.IdentityManagerApi.ListUsers(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	for _, user := range users {
+		if user.Id == id {
+			return user.Email, nil
+		}
+	}
+	return "", nil
+}
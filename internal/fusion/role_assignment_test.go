@@ -0,0 +1,180 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Assigning a role needs a user, other than the one running the tests
+const testRoleAssignmentUserEmailVar = "FUSION_TEST_ROLE_ASSIGNMENT_USER_EMAIL"
+
+// Assigns a role at the tenant space scope, then moves it to the tenant scope
+func TestAccRoleAssignment_basic(t *testing.T) {
+	userEmail := os.Getenv(testRoleAssignmentUserEmailVar)
+	if userEmail == "" {
+		t.Skipf("%s must be set to assign a role", testRoleAssignmentUserEmailVar)
+	}
+
+	rNameConfig := acctest.RandomWithPrefix("role_assignment_test")
+	rName := "fusion_role_assignment." + rNameConfig
+	tenantSpaceName := acctest.RandomWithPrefix("test_ts")
+
+	commonConfig := testTenantSpaceConfig("ts", "ts display name", tenantSpaceName, testAccTenant)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckRoleAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: commonConfig + testRoleAssignmentConfig(rNameConfig, "tenant-space-admin", userEmail, testAccTenant, "fusion_tenant_space.ts.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "role_name", "tenant-space-admin"),
+					resource.TestCheckResourceAttr(rName, "tenant_space_name", tenantSpaceName),
+					resource.TestCheckResourceAttr(rName, "scope_self_link", "/tenants/"+testAccTenant+"/tenant-spaces/"+tenantSpaceName),
+					resource.TestCheckResourceAttrSet(rName, "principal"),
+					testRoleAssignmentExists(rName),
+				),
+			},
+			// Import it back by id, the principal is resolved back to user_email
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: commonConfig + testRoleAssignmentConfig(rNameConfig, "tenant-space-admin", userEmail, testAccTenant, `""`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "tenant_space_name", ""),
+					resource.TestCheckResourceAttr(rName, "scope_self_link", "/tenants/"+testAccTenant),
+					testRoleAssignmentExists(rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoleAssignment_invalidScope(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "fusion_role_assignment" "ra" {
+					role_name         = "tenant-admin"
+					api_client_id     = "some-api-client"
+					tenant_name       = "some-tenant"
+					tenant_space_name = "some-tenant-space"
+				}
+				`,
+				ExpectError: regexp.MustCompile("role tenant-admin can't be assigned at the tenant_space scope"),
+			},
+			{
+				Config: `
+				resource "fusion_role_assignment" "ra" {
+					role_name = "tenant-admin"
+				}
+				`,
+				ExpectError: regexp.MustCompile("one of `api_client_id,user_email` must be specified"),
+			},
+		},
+	})
+}
+
+func TestRoleAssignmentScope(t *testing.T) {
+	tests := []struct {
+		tenantName, tenantSpaceName string
+		selfLink, kind              string
+	}{
+		{"", "", "/", roleAssignmentScopeOrganization},
+		{"t", "", "/tenants/t", roleAssignmentScopeTenant},
+		{"t", "ts", "/tenants/t/tenant-spaces/ts", roleAssignmentScopeTenantSpace},
+	}
+	for _, test := range tests {
+		selfLink, kind := roleAssignmentScope(test.tenantName, test.tenantSpaceName)
+		if selfLink != test.selfLink || kind != test.kind {
+			t.Errorf("roleAssignmentScope(%q, %q) = %q, %q, expected %q, %q",
+				test.tenantName, test.tenantSpaceName, selfLink, kind, test.selfLink, test.kind)
+		}
+		tenantName, tenantSpaceName, ok := parseRoleAssignmentScope(selfLink)
+		if !ok || tenantName != test.tenantName || tenantSpaceName != test.tenantSpaceName {
+			t.Errorf("parseRoleAssignmentScope(%q) = %q, %q, %v", selfLink, tenantName, tenantSpaceName, ok)
+		}
+	}
+
+	for _, selfLink := range []string{"", "/regions/r", "/tenants/t/volumes/v", "/tenants/t/tenant-spaces/ts/volumes/v"} {
+		if _, _, ok := parseRoleAssignmentScope(selfLink); ok {
+			t.Errorf("parseRoleAssignmentScope(%q) should fail", selfLink)
+		}
+	}
+}
+
+func testRoleAssignmentExists(rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfRoleAssignment, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rName)
+		}
+		if tfRoleAssignment.Type != "fusion_role_assignment" {
+			return fmt.Errorf("expected type: fusion_role_assignment. Found: %s", tfRoleAssignment.Type)
+		}
+		attrs := tfRoleAssignment.Primary.Attributes
+
+		goclientRoleAssignment, _, err := testAccProvider.Meta().(*hmrest.APIClient).RoleAssignmentsApi.GetRoleAssignment(context.Background(),
+			attrs["role_name"], attrs["name"], nil)
+		if err != nil {
+			return fmt.Errorf("go client returned error while searching for %s. Error: %s", attrs["name"], err)
+		}
+		if goclientRoleAssignment.Principal != attrs["principal"] || goclientRoleAssignment.Scope.SelfLink != attrs["scope_self_link"] {
+			return fmt.Errorf("terraform role assignment doesnt match goclients role assignment")
+		}
+		return nil
+	}
+}
+
+func testCheckRoleAssignmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*hmrest.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fusion_role_assignment" {
+			continue
+		}
+		attrs := rs.Primary.Attributes
+
+		_, resp, err := client.RoleAssignmentsApi.GetRoleAssignment(context.Background(), attrs["role_name"], attrs["name"], nil)
+		if err != nil && resp.StatusCode == http.StatusNotFound {
+			continue
+		} else {
+			return fmt.Errorf("role assignment may still exist. Expected response code 404, got code %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+// tenantSpaceName is HCL, so that it can reference a tenant space resource
+func testRoleAssignmentConfig(rName, roleName, userEmail, tenantName, tenantSpaceName string) string {
+	return fmt.Sprintf(`
+	resource "fusion_role_assignment" "%[1]s" {
+		role_name         = "%[2]s"
+		user_email        = "%[3]s"
+		tenant_name       = "%[4]s"
+		tenant_space_name = %[5]s
+	}
+	`, rName, roleName, userEmail, tenantName, tenantSpaceName)
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+	"github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/utilities"
+)
+
+var snapshotResourceFunctions *BaseResourceFunctions
+
+// Implements ResourceProvider
+type snapshotProvider struct {
+	BaseResourceProvider
+}
+
+// This is our entry point for the Snapshot resource. Get it movin'
+func resourceSnapshot() *schema.Resource {
+	vp := &snapshotProvider{BaseResourceProvider{ResourceKind: "Snapshot"}}
+	snapshotResourceFunctions = NewBaseResourceFunctions("Snapshot", vp)
+
+	snapshotResourceFunctions.Resource.Schema = map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"tenant_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"tenant_space_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"placement_group_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"placement_group_name", "volume_names"},
+			Description:  "Take a snapshot of every volume in this placement group.",
+		},
+		"volume_names": {
+			Type:         schema.TypeSet,
+			Optional:     true,
+			ForceNew:     true,
+			MinItems:     1,
+			ExactlyOneOf: []string{"placement_group_name", "volume_names"},
+			Description: "Take a snapshot of these volumes. Fusion doesn't record whether a snapshot was taken of a whole placement group, " +
+				"imported snapshots are read with this argument.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"destroyed": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"volume_snapshots": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The volume snapshots taken as part of this snapshot.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"self_link": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"serial_number": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"volume_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"volume_serial_number": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"size": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"created_at": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"consistency_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+	// The API can only destroy or recover a snapshot, every change to the arguments is a replacement.
+	snapshotResourceFunctions.Resource.UpdateContext = nil
+
+	return snapshotResourceFunctions.Resource
+}
+
+func (vp *snapshotProvider) PrepareCreate(ctx context.Context, d *schema.ResourceData) (InvokeWriteAPI, ResourcePost, error) {
+	name := rdString(ctx, d, "name")
+	displayName := rdStringDefault(ctx, d, "display_name", name)
+	tenantName := rdString(ctx, d, "tenant_name")
+	tenantSpaceName := rdString(ctx, d, "tenant_space_name")
+
+	body := hmrest.SnapshotPost{
+		Name:           name,
+		DisplayName:    displayName,
+		PlacementGroup: rdString(ctx, d, "placement_group_name"),
+	}
+	for _, volumeName := range d.Get("volume_names").(*schema.Set).List() {
+		body.Volumes = append(body.Volumes, volumeName.(string))
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		post := body.(*hmrest.SnapshotPost)
+		requestId, err := uuid.GenerateUUID()
+		if err != nil {
+			return nil, err
+		}
+		var op hmrest.Operation
+		if post.PlacementGroup != "" {
+			op, err = client.SnapshotsApi.CreateSnapshotByPlacementGroup(ctx, tenantName, tenantSpaceName,
+				post.PlacementGroup, post.Name, post.DisplayName, requestId)
+		} else {
+			op, err = client.SnapshotsApi.CreateSnapshotByVolumes(ctx, tenantName, tenantSpaceName,
+				post.Volumes, post.Name, post.DisplayName, requestId)
+		}
+		return &op, err
+	}
+	return fn, &body, nil
+}
+
+func (vp *snapshotProvider) ReadResource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	snap, _, err := client.SnapshotsApi.GetSnapshotById(ctx, d.Id(), nil)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", snap.Name)
+	d.Set("display_name", snap.DisplayName)
+	d.Set("tenant_name", snap.Tenant.Name)
+	d.Set("tenant_space_name", snap.TenantSpace.Name)
+	d.Set("destroyed", snap.Destroyed)
+
+	volumeSnapshots, _, err := client.VolumeSnapshotsApi.ListVolumeSnapshots(ctx, snap.Tenant.Name, snap.TenantSpace.Name, snap.Name, nil)
+	if err != nil {
+		return err
+	}
+
+	items := []map[string]interface{}{}
+	volumeNames := []string{}
+	placementGroupName := ""
+	for _, vs := range volumeSnapshots.Items {
+		volumeName := ""
+		if vs.Volume != nil {
+			volumeName = vs.Volume.Name
+			volumeNames = append(volumeNames, volumeName)
+		}
+		if vs.PlacementGroup != nil {
+			placementGroupName = vs.PlacementGroup.Name
+		}
+		items = append(items, map[string]interface{}{
+			"id":                   vs.Id,
+			"name":                 vs.Name,
+			"self_link":            vs.SelfLink,
+			"serial_number":        vs.SerialNumber,
+			"volume_name":          volumeName,
+			"volume_serial_number": vs.VolumeSerialNumber,
+			"size":                 vs.Size,
+			"created_at":           vs.CreatedAt,
+			"consistency_id":       vs.ConsistencyId,
+		})
+	}
+	// The API can't tell a snapshot of a placement group apart, keep reading the source it was taken of
+	if d.Get("placement_group_name").(string) == "" {
+		d.Set("volume_names", volumeNames)
+	} else if placementGroupName != "" {
+		d.Set("placement_group_name", placementGroupName)
+	}
+	return d.Set("volume_snapshots", items)
+}
+
+// snapshotProvider.PrepareDelete destroys the snapshot and then eradicates it.
+func (vp *snapshotProvider) PrepareDelete(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, error) {
+	snapshotName := rdString(ctx, d, "name")
+	tenantName := rdString(ctx, d, "tenant_name")
+	tenantSpaceName := rdString(ctx, d, "tenant_space_name")
+	destroyed := d.Get("destroyed").(bool)
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		if !destroyed {
+			tflog.Trace(ctx, "destroying snapshot before eradicating it")
+			op, _, err := client.SnapshotsApi.UpdateSnapshot(ctx, hmrest.SnapshotPatch{
+				Destroyed: &hmrest.NullableBoolean{Value: true},
+			}, tenantName, tenantSpaceName, snapshotName, nil)
+			utilities.TraceError(ctx, err)
+			if err != nil {
+				return &op, err
+			}
+			succeeded, err := utilities.WaitOnOperation(ctx, &op, client)
+			if err != nil {
+				return &op, err
+			}
+			if !succeeded {
+				tflog.Error(ctx, "failed destroying snapshot")
+				return &op, fmt.Errorf("failed to destroy snapshot as part of deleting it")
+			}
+			tflog.Trace(ctx, "done destroying snapshot")
+		}
+
+		op, _, err := client.SnapshotsApi.DeleteSnapshot(ctx, tenantName, tenantSpaceName, snapshotName, nil)
+		return &op, err
+	}
+	return fn, nil
+}
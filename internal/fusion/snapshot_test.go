@@ -0,0 +1,148 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+func TestAccSnapshot_basic(t *testing.T) {
+	tsName := acctest.RandomWithPrefix("ts-snapTest")
+	pgName := acctest.RandomWithPrefix("pg-snapTest")
+	scName := acctest.RandomWithPrefix("sc-snapTest")
+	volName := acctest.RandomWithPrefix("vol-snapTest")
+	pgSnapName := acctest.RandomWithPrefix("pgsnap-snapTest")
+	volSnapName := acctest.RandomWithPrefix("volsnap-snapTest")
+
+	commonConfig := testSnapshotVolumeConfig(tsName, pgName, scName, volName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: commonConfig +
+					testSnapshotConfig("pg_snap", pgSnapName, "fusion_tenant_space.ts.name", `
+						placement_group_name = fusion_placement_group.pg.name
+						depends_on = [fusion_volume.vol]`) +
+					testSnapshotConfig("vol_snap", volSnapName, "fusion_tenant_space.ts.name", `volume_names = [fusion_volume.vol.name]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fusion_snapshot.pg_snap", "name", pgSnapName),
+					resource.TestCheckResourceAttr("fusion_snapshot.pg_snap", "destroyed", "false"),
+					resource.TestCheckResourceAttr("fusion_snapshot.pg_snap", "volume_snapshots.#", "1"),
+					resource.TestCheckResourceAttr("fusion_snapshot.pg_snap", "volume_snapshots.0.volume_name", volName),
+					resource.TestCheckResourceAttr("fusion_snapshot.vol_snap", "volume_snapshots.#", "1"),
+					resource.TestCheckResourceAttrSet("fusion_snapshot.vol_snap", "volume_snapshots.0.serial_number"),
+					testSnapshotExists("fusion_snapshot.pg_snap"),
+					testSnapshotExists("fusion_snapshot.vol_snap"),
+				),
+			},
+			{
+				ResourceName:      "fusion_snapshot.vol_snap",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSnapshot_invalidSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: testSnapshotConfig("snap", "snap", `"ts"`, `
+					placement_group_name = "pg"
+					volume_names = ["vol"]`),
+				ExpectError: regexp.MustCompile(`only one of .placement_group_name,volume_names. can be specified`),
+			},
+		},
+	})
+}
+
+func testSnapshotExists(rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfSnapshot, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rName)
+		}
+		if tfSnapshot.Type != "fusion_snapshot" {
+			return fmt.Errorf("expected type: fusion_snapshot. Found: %s", tfSnapshot.Type)
+		}
+		attrs := tfSnapshot.Primary.Attributes
+
+		goclientSnapshot, _, err := testAccProvider.Meta().(*hmrest.APIClient).SnapshotsApi.GetSnapshot(context.Background(),
+			attrs["tenant_name"], attrs["tenant_space_name"], attrs["name"], nil)
+		if err != nil {
+			return fmt.Errorf("go client returned error while searching for %s. Error: %s", attrs["name"], err)
+		}
+		if goclientSnapshot.Name != attrs["name"] || goclientSnapshot.DisplayName != attrs["display_name"] {
+			return fmt.Errorf("terraform snapshot doesnt match goclients snapshot")
+		}
+		return nil
+	}
+}
+
+func testCheckSnapshotDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*hmrest.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fusion_snapshot" {
+			continue
+		}
+		attrs := rs.Primary.Attributes
+
+		_, resp, err := client.SnapshotsApi.GetSnapshot(context.Background(), attrs["tenant_name"], attrs["tenant_space_name"], attrs["name"], nil)
+		if err != nil && resp.StatusCode == http.StatusNotFound {
+			continue
+		} else {
+			return fmt.Errorf("snapshot may still exist. Expected response code 404, got code %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+// A tenant space, placement group, storage class and a single volume to take snapshots of
+func testSnapshotVolumeConfig(tsName, pgName, scName, volName string) string {
+	return testTenantSpaceConfig("ts", "ts display name", tsName, testAccTenant) +
+		testPGConfig("", "pg", pgName, "pg display name", region_name, availability_zone_name, testAccStorageService, true) +
+		testStorageClassConfig("sc", scName, "sc display name", testAccStorageService, "1T", "100K", "1G/s") +
+		fmt.Sprintf(`
+	resource "fusion_volume" "vol" {
+		name                 = "%[1]s"
+		tenant_name          = fusion_tenant_space.ts.tenant_name
+		tenant_space_name    = fusion_tenant_space.ts.name
+		storage_class_name   = fusion_storage_class.sc.name
+		placement_group_name = fusion_placement_group.pg.name
+		size                 = 1048576
+		host_names           = []
+	}
+	`, volName)
+}
+
+// tenantSpace and source are HCL expressions
+func testSnapshotConfig(rName, snapshotName, tenantSpace, source string) string {
+	return fmt.Sprintf(`
+	resource "fusion_snapshot" "%[1]s" {
+		name              = "%[2]s"
+		tenant_name       = "%[3]s"
+		tenant_space_name = %[4]s
+		%[5]s
+	}
+	`, rName, snapshotName, testAccTenant, tenantSpace, source)
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// The kinds of resources space and performance are reported for, and the names making up their resource_path
+var metricsResourcePaths = map[string][]string{
+	"array":             {"region", "availability_zone", "array"},
+	"availability_zone": {"region", "availability_zone"},
+	"tenant":            {"tenant"},
+	"tenant_space":      {"tenant", "tenant_space"},
+	"placement_group":   {"tenant", "tenant_space", "placement_group"},
+	"volume":            {"tenant", "tenant_space", "volume"},
+}
+
+// Implements DataSourceProvider
+type spaceDataSourceProvider struct{}
+
+// This is our entry point for the Space data source
+func dataSourceSpace() *schema.Resource {
+	ds := NewBaseDataSourceFunctions("Space", &spaceDataSourceProvider{})
+
+	ds.Resource.Schema = metricsResourceSchema()
+	ds.Resource.Schema["total_physical_space"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "In bytes.",
+	}
+	ds.Resource.Schema["unique_space"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "In bytes.",
+	}
+	ds.Resource.Schema["snapshot_space"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "In bytes.",
+	}
+
+	return ds.Resource
+}
+
+func (ds *spaceDataSourceProvider) ReadDataSource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	kind := rdString(ctx, d, "resource_kind")
+	names, err := parseMetricsResourcePath(kind, rdString(ctx, d, "resource_path"))
+	if err != nil {
+		return err
+	}
+
+	var space hmrest.Space
+	switch kind {
+	case "array":
+		space, _, err = client.ArraysApi.GetArraySpace(ctx, names[0], names[1], names[2], nil)
+	case "availability_zone":
+		space, _, err = client.AvailabilityZonesApi.GetAvailabilityZoneSpace(ctx, names[0], names[1], nil)
+	case "tenant":
+		space, _, err = client.TenantsApi.GetTenantsSpace(ctx, names[0], nil)
+	case "tenant_space":
+		space, _, err = client.TenantSpacesApi.GetTenantSpaceSpace(ctx, names[0], names[1], nil)
+	case "placement_group":
+		space, _, err = client.PlacementGroupsApi.GetPlacementGroupsSpace(ctx, names[0], names[1], names[2], nil)
+	case "volume":
+		space, _, err = client.VolumesApi.GetVolumeSpace(ctx, names[0], names[1], names[2], nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId(dataSourceQueryId("space", kind, names))
+	d.Set("total_physical_space", space.TotalPhysicalSpace)
+	d.Set("unique_space", space.UniqueSpace)
+	d.Set("snapshot_space", space.SnapshotSpace)
+	return setMetricsResource(d, space.Resource)
+}
+
+// metricsResourceSchema selects the resource of the space and performance data sources
+func metricsResourceSchema() map[string]*schema.Schema {
+	var kinds []string
+	for kind := range metricsResourcePaths {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	return map[string]*schema.Schema{
+		"resource_kind": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(kinds, false),
+			Description:  "One of `" + strings.Join(kinds, "`, `") + "`",
+		},
+		"resource_path": {
+			Type:     schema.TypeString,
+			Required: true,
+			Description: "The names leading to the resource, separated by slashes, e.g. `region/availability_zone/array` for an array " +
+				"or `tenant/tenant_space/volume` for a volume.",
+		},
+		"resource": computedRefSchema("The resource as resolved by Fusion."),
+	}
+}
+
+func parseMetricsResourcePath(kind, path string) ([]string, error) {
+	expected, ok := metricsResourcePaths[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource_kind %s", kind)
+	}
+	names := strings.Split(path, "/")
+	if len(names) != len(expected) {
+		return nil, fmt.Errorf("resource_path of a %s must look like %s, got %q", kind, strings.Join(expected, "/"), path)
+	}
+	for _, name := range names {
+		if name == "" {
+			return nil, fmt.Errorf("resource_path of a %s must look like %s, got %q", kind, strings.Join(expected, "/"), path)
+		}
+	}
+	return names, nil
+}
+
+func setMetricsResource(d *schema.ResourceData, ref *hmrest.ResourceReference) error {
+	resourceRef := []map[string]interface{}{}
+	if ref != nil {
+		resourceRef = append(resourceRef, map[string]interface{}{
+			"id":        ref.Id,
+			"name":      ref.Name,
+			"kind":      ref.Kind,
+			"self_link": ref.SelfLink,
+		})
+	}
+	return d.Set("resource", resourceRef)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Reads the space of a volume and of the tenant space it is in
+func TestAccSpaceDataSource_basic(t *testing.T) {
+	tsName := acctest.RandomWithPrefix("ts-spaceDataSourceTest")
+	pgName := acctest.RandomWithPrefix("pg-spaceDataSourceTest")
+	scName := acctest.RandomWithPrefix("sc-spaceDataSourceTest")
+	volName := acctest.RandomWithPrefix("vol-spaceDataSourceTest")
+
+	commonConfig := testSnapshotVolumeConfig(tsName, pgName, scName, volName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: commonConfig + testMetricsDataSourceConfig("fusion_space", "volume", "volume",
+					`"${fusion_volume.vol.tenant_name}/${fusion_volume.vol.tenant_space_name}/${fusion_volume.vol.name}"`) +
+					testMetricsDataSourceConfig("fusion_space", "tenant_space", "tenant_space",
+						`"${fusion_tenant_space.ts.tenant_name}/${fusion_tenant_space.ts.name}"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.fusion_space.volume", "resource.0.id", "fusion_volume.vol", "id"),
+					resource.TestCheckResourceAttrSet("data.fusion_space.volume", "unique_space"),
+					resource.TestCheckResourceAttrSet("data.fusion_space.volume", "total_physical_space"),
+					resource.TestCheckResourceAttrPair("data.fusion_space.tenant_space", "resource.0.id", "fusion_tenant_space.ts", "id"),
+				),
+			},
+			{
+				Config:      commonConfig + testMetricsDataSourceConfig("fusion_space", "volume", "volume", `"`+testAccTenant+`/`+tsName+`"`),
+				ExpectError: regexp.MustCompile("resource_path of a volume must look like tenant/tenant_space/volume"),
+			},
+		},
+	})
+}
+
+func TestParseMetricsResourcePath(t *testing.T) {
+	names, err := parseMetricsResourcePath("array", "region/az/array")
+	if err != nil || !reflect.DeepEqual(names, []string{"region", "az", "array"}) {
+		t.Errorf("parseMetricsResourcePath(array) = %v, %v", names, err)
+	}
+	names, err = parseMetricsResourcePath("tenant", "tenant")
+	if err != nil || !reflect.DeepEqual(names, []string{"tenant"}) {
+		t.Errorf("parseMetricsResourcePath(tenant) = %v, %v", names, err)
+	}
+
+	invalid := []struct{ kind, path string }{
+		{"array", "region/az"},
+		{"tenant", "tenant/tenant_space"},
+		{"volume", "tenant//volume"},
+		{"host", "host"},
+	}
+	for _, test := range invalid {
+		if _, err := parseMetricsResourcePath(test.kind, test.path); err == nil {
+			t.Errorf("parseMetricsResourcePath(%q, %q) should fail", test.kind, test.path)
+		}
+	}
+}
+
+// path is an HCL expression
+func testMetricsDataSourceConfig(dataSource, rName, kind, path string) string {
+	return fmt.Sprintf(`
+	data "%[1]s" "%[2]s" {
+		resource_kind = "%[3]s"
+		resource_path = %[4]s
+	}
+	`, dataSource, rName, kind, path)
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	context "context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+var storageClassResourceFunctions *BaseResourceFunctions
+
+// Implements ResourceProvider
+type storageClassProvider struct {
+	BaseResourceProvider
+}
+
+// This is our entry point for the Storage Class resource. Get it movin'
+func resourceStorageClass() *schema.Resource {
+	vp := &storageClassProvider{BaseResourceProvider{ResourceKind: "StorageClass"}}
+	storageClassResourceFunctions = NewBaseResourceFunctions("StorageClass", vp)
+
+	storageClassResourceFunctions.Resource.Schema = map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"storage_service_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"size_limit": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			Default:          "4P",
+			Description:      "The maximum volume size, e.g. `10T`. Units are powers of 1024.",
+			ValidateDiagFunc: validateUnits(parseSize),
+			DiffSuppressFunc: suppressEquivalentUnits(parseSize),
+		},
+		"iops_limit": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			Default:          "100M",
+			Description:      "The maximum IOPS per volume, e.g. `100K IOPS`. Units are powers of 1000.",
+			ValidateDiagFunc: validateUnits(parseIops),
+			DiffSuppressFunc: suppressEquivalentUnits(parseIops),
+		},
+		"bandwidth_limit": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			Default:          "512G/s",
+			Description:      "The maximum bandwidth per volume, e.g. `500M/s`. Units are powers of 1024.",
+			ValidateDiagFunc: validateUnits(parseBandwidth),
+			DiffSuppressFunc: suppressEquivalentUnits(parseBandwidth),
+		},
+	}
+	storageClassResourceFunctions.AddImmutableAttributes("name", "storage_service_name")
+
+	return storageClassResourceFunctions.Resource
+}
+
+func (vp *storageClassProvider) PrepareCreate(ctx context.Context, d *schema.ResourceData) (InvokeWriteAPI, ResourcePost, error) {
+	name := rdString(ctx, d, "name")
+	displayName := rdStringDefault(ctx, d, "display_name", name)
+	storageServiceName := rdString(ctx, d, "storage_service_name")
+
+	// These have been validated already, so errors here are unexpected
+	sizeLimit, err := parseSize(rdString(ctx, d, "size_limit"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("size_limit: %w", err)
+	}
+	iopsLimit, err := parseIops(rdString(ctx, d, "iops_limit"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("iops_limit: %w", err)
+	}
+	bandwidthLimit, err := parseBandwidth(rdString(ctx, d, "bandwidth_limit"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("bandwidth_limit: %w", err)
+	}
+
+	body := hmrest.StorageClassPost{
+		Name:           name,
+		DisplayName:    displayName,
+		SizeLimit:      sizeLimit,
+		IopsLimit:      iopsLimit,
+		BandwidthLimit: bandwidthLimit,
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.StorageClassesApi.CreateStorageClass(ctx, *body.(*hmrest.StorageClassPost), storageServiceName, nil)
+		return &op, err
+	}
+	return fn, &body, nil
+}
+
+func (vp *storageClassProvider) ReadResource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	sc, _, err := client.StorageClassesApi.GetStorageClassById(ctx, d.Id(), nil)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", sc.Name)
+	d.Set("display_name", sc.DisplayName)
+	d.Set("storage_service_name", sc.StorageService.Name)
+
+	if err := setUnitsAttr(d, "size_limit", sc.SizeLimit, parseSize, formatSize); err != nil {
+		return err
+	}
+	if err := setUnitsAttr(d, "iops_limit", sc.IopsLimit, parseIops, formatIops); err != nil {
+		return err
+	}
+	return setUnitsAttr(d, "bandwidth_limit", sc.BandwidthLimit, parseBandwidth, formatBandwidth)
+}
+
+func (vp *storageClassProvider) PrepareDelete(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, error) {
+	storageServiceName := rdString(ctx, d, "storage_service_name")
+	storageClassName := rdString(ctx, d, "name")
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.StorageClassesApi.DeleteStorageClass(ctx, storageServiceName, storageClassName, nil)
+		return &op, err
+	}
+	return fn, nil
+}
+
+func (vp *storageClassProvider) PrepareUpdate(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, []ResourcePatch, error) {
+	var patches []ResourcePatch // []*hmrest.StorageClassPatch
+
+	storageServiceName := rdString(ctx, d, "storage_service_name")
+	storageClassName := rdString(ctx, d, "name")
+
+	if d.HasChange("display_name") {
+		displayName := rdString(ctx, d, "display_name")
+		tflog.Info(ctx, "Updating", "display_name", displayName)
+		patches = append(patches, &hmrest.StorageClassPatch{
+			DisplayName: &hmrest.NullableString{Value: displayName},
+		})
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.StorageClassesApi.UpdateStorageClass(ctx, *body.(*hmrest.StorageClassPatch), storageServiceName, storageClassName, nil)
+		return &op, err
+	}
+	return fn, patches, nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Creates and destroys
+func TestAccStorageClass_basic(t *testing.T) {
+	rNameConfig := acctest.RandomWithPrefix("storage_class_test")
+	rName := "fusion_storage_class." + rNameConfig
+	displayName := acctest.RandomWithPrefix("storage-class-display-name")
+	storageClassName := acctest.RandomWithPrefix("test_sc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckStorageClassDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testStorageClassConfig(rNameConfig, storageClassName, displayName, testAccStorageService, "10T", "100K IOPS", "500M/s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "name", storageClassName),
+					resource.TestCheckResourceAttr(rName, "display_name", displayName),
+					resource.TestCheckResourceAttr(rName, "storage_service_name", testAccStorageService),
+					resource.TestCheckResourceAttr(rName, "size_limit", "10T"),
+					resource.TestCheckResourceAttr(rName, "iops_limit", "100K IOPS"),
+					resource.TestCheckResourceAttr(rName, "bandwidth_limit", "500M/s"),
+					testStorageClassExists(rName, 10<<40, 100000, 500<<20),
+				),
+			},
+			// Same limits spelled differently must not produce a diff
+			{
+				Config:   testStorageClassConfig(rNameConfig, storageClassName, displayName, testAccStorageService, "10240G", "100000", "500MB/s"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// Updates display name, replaces on limit changes
+func TestAccStorageClass_update(t *testing.T) {
+	rNameConfig := acctest.RandomWithPrefix("storage_class_test")
+	rName := "fusion_storage_class." + rNameConfig
+	displayName1 := acctest.RandomWithPrefix("storage-class-display-name")
+	displayName2 := acctest.RandomWithPrefix("storage-class-display-name2")
+	storageClassName := acctest.RandomWithPrefix("test_sc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckStorageClassDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testStorageClassConfig(rNameConfig, storageClassName, displayName1, testAccStorageService, "10T", "100K", "500M/s"),
+				Check:  testStorageClassExists(rName, 10<<40, 100000, 500<<20),
+			},
+			{
+				Config: testStorageClassConfig(rNameConfig, storageClassName, displayName2, testAccStorageService, "10T", "100K", "500M/s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "display_name", displayName2),
+					testStorageClassExists(rName, 10<<40, 100000, 500<<20),
+				),
+			},
+			{
+				Config: testStorageClassConfig(rNameConfig, storageClassName, displayName2, testAccStorageService, "20T", "200K", "1G/s"),
+				Check:  testStorageClassExists(rName, 20<<40, 200000, 1<<30),
+			},
+			{
+				Config:      testStorageClassConfig(rNameConfig, storageClassName, displayName2, testAccStorageService, "20X", "200K", "1G/s"),
+				ExpectError: regexp.MustCompile("unknown unit"),
+			},
+		},
+	})
+}
+
+func testStorageClassExists(rName string, sizeLimit, iopsLimit, bandwidthLimit int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfStorageClass, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rName)
+		}
+		if tfStorageClass.Type != "fusion_storage_class" {
+			return fmt.Errorf("expected type: fusion_storage_class. Found: %s", tfStorageClass.Type)
+		}
+		attrs := tfStorageClass.Primary.Attributes
+
+		goclientStorageClass, _, err := testAccProvider.Meta().(*hmrest.APIClient).StorageClassesApi.GetStorageClass(context.Background(), attrs["storage_service_name"], attrs["name"], nil)
+		if err != nil {
+			return fmt.Errorf("go client returned error while searching for %s. Error: %s", attrs["name"], err)
+		}
+		if strings.Compare(goclientStorageClass.Name, attrs["name"]) != 0 ||
+			strings.Compare(goclientStorageClass.DisplayName, attrs["display_name"]) != 0 ||
+			goclientStorageClass.SizeLimit != sizeLimit ||
+			goclientStorageClass.IopsLimit != iopsLimit ||
+			goclientStorageClass.BandwidthLimit != bandwidthLimit {
+			return fmt.Errorf("terraform storage class doesnt match goclients storage class")
+		}
+		return nil
+	}
+}
+
+func testCheckStorageClassDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*hmrest.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fusion_storage_class" {
+			continue
+		}
+		attrs := rs.Primary.Attributes
+
+		_, resp, err := client.StorageClassesApi.GetStorageClass(context.Background(), attrs["storage_service_name"], attrs["name"], nil)
+		if err != nil && resp.StatusCode == http.StatusNotFound {
+			continue
+		} else {
+			return fmt.Errorf("storage class may still exist. Expected response code 404, got code %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+func testStorageClassConfig(rName, storageClassName, displayName, storageServiceName, sizeLimit, iopsLimit, bandwidthLimit string) string {
+	return fmt.Sprintf(`
+	resource "fusion_storage_class" "%[1]s" {
+		name                 = "%[2]s"
+		display_name         = "%[3]s"
+		storage_service_name = "%[4]s"
+		size_limit           = "%[5]s"
+		iops_limit           = "%[6]s"
+		bandwidth_limit      = "%[7]s"
+	}
+	`, rName, storageClassName, displayName, storageServiceName, sizeLimit, iopsLimit, bandwidthLimit)
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	context "context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+var storageEndpointResourceFunctions *BaseResourceFunctions
+
+// Implements ResourceProvider
+type storageEndpointProvider struct {
+	BaseResourceProvider
+}
+
+const (
+	storageEndpointTypeIscsi         = "iscsi"
+	storageEndpointTypeCbsAzureIscsi = "cbs-azure-iscsi"
+)
+
+// This is our entry point for the Storage Endpoint resource. Get it movin'
+func resourceStorageEndpoint() *schema.Resource {
+	vp := &storageEndpointProvider{BaseResourceProvider{ResourceKind: "StorageEndpoint"}}
+	storageEndpointResourceFunctions = NewBaseResourceFunctions("StorageEndpoint", vp)
+
+	storageEndpointResourceFunctions.Resource.Schema = map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"region_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"availability_zone_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"endpoint_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"iscsi": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"iscsi", "cbs_azure_iscsi"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"discovery_interfaces": {
+						Type:     schema.TypeList,
+						ForceNew: true,
+						Required: true,
+						MinItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"address": {
+									Type:             schema.TypeString,
+									ForceNew:         true,
+									Required:         true,
+									Description:      "IPv4 address, optionally with its prefix length, it must be inside the prefix of the network interface groups",
+									ValidateDiagFunc: validateNetworkInterfaceAddress,
+								},
+								"gateway": {
+									Type:             schema.TypeString,
+									ForceNew:         true,
+									Optional:         true,
+									ValidateDiagFunc: validateIPv4Address,
+								},
+								"network_interface_groups": {
+									Type:     schema.TypeSet,
+									ForceNew: true,
+									Optional: true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"mtu": {
+									Type:     schema.TypeInt,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"cbs_azure_iscsi": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"iscsi", "cbs_azure_iscsi"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"storage_endpoint_collection_identity": {
+						Type:        schema.TypeString,
+						ForceNew:    true,
+						Optional:    true,
+						Description: "The Storage Endpoint Collection Identity which belongs to the Azure entities",
+					},
+					"load_balancer": {
+						Type:        schema.TypeString,
+						ForceNew:    true,
+						Optional:    true,
+						Description: "Id of the Load Balancer the CBS array applications are allowed to modify",
+					},
+					"load_balancer_addresses": {
+						Type:     schema.TypeList,
+						ForceNew: true,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:             schema.TypeString,
+							ValidateDiagFunc: validateIPv4Address,
+						},
+					},
+				},
+			},
+		},
+	}
+	storageEndpointResourceFunctions.AddCustomizeDiff(validateStorageEndpointDiscoveryAddresses)
+	storageEndpointResourceFunctions.AddImmutableAttributes("name", "region_name", "availability_zone_name")
+
+	return storageEndpointResourceFunctions.Resource
+}
+
+func (vp *storageEndpointProvider) PrepareCreate(ctx context.Context, d *schema.ResourceData) (InvokeWriteAPI, ResourcePost, error) {
+	name := rdString(ctx, d, "name")
+	displayName := rdStringDefault(ctx, d, "display_name", name)
+	regionName := rdString(ctx, d, "region_name")
+	availabilityZoneName := rdString(ctx, d, "availability_zone_name")
+
+	body := hmrest.StorageEndpointPost{
+		Name:        name,
+		DisplayName: displayName,
+	}
+	if _, ok := d.GetOk("iscsi"); ok {
+		body.EndpointType = storageEndpointTypeIscsi
+		body.Iscsi = &hmrest.StorageEndpointIscsiPost{}
+		for _, item := range d.Get("iscsi.0.discovery_interfaces").([]interface{}) {
+			discoveryInterface := item.(map[string]interface{})
+			post := hmrest.StorageEndpointIscsiDiscoveryInterfacePost{
+				Address: discoveryInterface["address"].(string),
+				Gateway: discoveryInterface["gateway"].(string),
+			}
+			for _, nigName := range discoveryInterface["network_interface_groups"].(*schema.Set).List() {
+				post.NetworkInterfaceGroups = append(post.NetworkInterfaceGroups, nigName.(string))
+			}
+			body.Iscsi.DiscoveryInterfaces = append(body.Iscsi.DiscoveryInterfaces, post)
+		}
+	} else {
+		body.EndpointType = storageEndpointTypeCbsAzureIscsi
+		body.CbsAzureIscsi = &hmrest.StorageEndpointCbsAzureIscsiPost{
+			StorageEndpointCollectionIdentity: rdString(ctx, d, "cbs_azure_iscsi.0.storage_endpoint_collection_identity"),
+			LoadBalancer:                      rdString(ctx, d, "cbs_azure_iscsi.0.load_balancer"),
+		}
+		for _, address := range d.Get("cbs_azure_iscsi.0.load_balancer_addresses").([]interface{}) {
+			body.CbsAzureIscsi.LoadBalancerAddresses = append(body.CbsAzureIscsi.LoadBalancerAddresses, address.(string))
+		}
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.StorageEndpointsApi.CreateStorageEndpoint(ctx, *body.(*hmrest.StorageEndpointPost),
+			regionName, availabilityZoneName, nil)
+		return &op, err
+	}
+	return fn, &body, nil
+}
+
+func (vp *storageEndpointProvider) ReadResource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	se, _, err := client.StorageEndpointsApi.GetStorageEndpointById(ctx, d.Id(), nil)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", se.Name)
+	d.Set("display_name", se.DisplayName)
+	d.Set("endpoint_type", se.EndpointType)
+	if se.Region != nil {
+		d.Set("region_name", se.Region.Name)
+	}
+	if se.AvailabilityZone != nil {
+		d.Set("availability_zone_name", se.AvailabilityZone.Name)
+	}
+
+	iscsi := []map[string]interface{}{}
+	if se.Iscsi != nil {
+		discoveryInterfaces := []map[string]interface{}{}
+		for i, discoveryInterface := range se.Iscsi.DiscoveryInterfaces {
+			networkInterfaceGroups := []string{}
+			for _, nig := range discoveryInterface.NetworkInterfaceGroups {
+				networkInterfaceGroups = append(networkInterfaceGroups, nig.Name)
+			}
+			configuredAddress := rdString(ctx, d, fmt.Sprintf("iscsi.0.discovery_interfaces.%d.address", i))
+			discoveryInterfaces = append(discoveryInterfaces, map[string]interface{}{
+				"address":                  keepEquivalentAddress(configuredAddress, discoveryInterface.Address),
+				"gateway":                  discoveryInterface.Gateway,
+				"network_interface_groups": networkInterfaceGroups,
+				"mtu":                      discoveryInterface.Mtu,
+			})
+		}
+		iscsi = append(iscsi, map[string]interface{}{"discovery_interfaces": discoveryInterfaces})
+	}
+	if err := d.Set("iscsi", iscsi); err != nil {
+		return err
+	}
+
+	cbsAzureIscsi := []map[string]interface{}{}
+	if se.CbsAzureIscsi != nil {
+		cbsAzureIscsi = append(cbsAzureIscsi, map[string]interface{}{
+			"storage_endpoint_collection_identity": se.CbsAzureIscsi.StorageEndpointCollectionIdentity,
+			"load_balancer":                        se.CbsAzureIscsi.LoadBalancer,
+			"load_balancer_addresses":              se.CbsAzureIscsi.LoadBalancerAddresses,
+		})
+	}
+	return d.Set("cbs_azure_iscsi", cbsAzureIscsi)
+}
+
+func (vp *storageEndpointProvider) PrepareDelete(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, error) {
+	storageEndpointName := rdString(ctx, d, "name")
+	regionName := rdString(ctx, d, "region_name")
+	availabilityZoneName := rdString(ctx, d, "availability_zone_name")
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.StorageEndpointsApi.DeleteStorageEndpoint(ctx, regionName, availabilityZoneName, storageEndpointName, nil)
+		return &op, err
+	}
+	return fn, nil
+}
+
+func (vp *storageEndpointProvider) PrepareUpdate(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, []ResourcePatch, error) {
+	var patches []ResourcePatch // []*hmrest.StorageEndpointPatch
+
+	storageEndpointName := rdString(ctx, d, "name")
+	regionName := rdString(ctx, d, "region_name")
+	availabilityZoneName := rdString(ctx, d, "availability_zone_name")
+	if d.HasChange("display_name") {
+		displayName := rdString(ctx, d, "display_name")
+		tflog.Info(ctx, "Updating", "display_name", displayName)
+		patches = append(patches, &hmrest.StorageEndpointPatch{
+			DisplayName: &hmrest.NullableString{Value: displayName},
+		})
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.StorageEndpointsApi.UpdateStorageEndpoint(ctx, *body.(*hmrest.StorageEndpointPatch),
+			regionName, availabilityZoneName, storageEndpointName, nil)
+		return &op, err
+	}
+	return fn, patches, nil
+}
+
+// validateStorageEndpointDiscoveryAddresses makes sure every iSCSI discovery address is inside the prefix
+// of the network interface groups it is served from, so that a typo is reported by `terraform plan`.
+func validateStorageEndpointDiscoveryAddresses(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("iscsi") || !d.NewValueKnown("iscsi") || !d.NewValueKnown("region_name") || !d.NewValueKnown("availability_zone_name") {
+		return nil
+	}
+	client, ok := m.(*hmrest.APIClient)
+	if !ok || client == nil {
+		return nil
+	}
+	regionName := d.Get("region_name").(string)
+	availabilityZoneName := d.Get("availability_zone_name").(string)
+
+	prefixes := map[string]string{} // network interface group name -> prefix
+	for i, item := range d.Get("iscsi.0.discovery_interfaces").([]interface{}) {
+		discoveryInterface := item.(map[string]interface{})
+		address := strings.Split(discoveryInterface["address"].(string), "/")[0]
+		ip := net.ParseIP(address)
+		if ip == nil {
+			continue // reported by the address validation
+		}
+
+		for _, nigName := range discoveryInterface["network_interface_groups"].(*schema.Set).List() {
+			prefix, ok := prefixes[nigName.(string)]
+			if !ok {
+				nig, resp, err := client.NetworkInterfaceGroupsApi.GetNetworkInterfaceGroup(ctx, regionName, availabilityZoneName, nigName.(string), nil)
+				if err != nil && resp != nil && resp.StatusCode == http.StatusNotFound {
+					// The group may be created in the same apply, Fusion checks the address then
+					tflog.Debug(ctx, "network interface group not found, skipping address check", "network_interface_group_name", nigName)
+					continue
+				} else if err != nil {
+					return err
+				}
+				if nig.Eth != nil {
+					prefix = nig.Eth.Prefix
+				}
+				prefixes[nigName.(string)] = prefix
+			}
+
+			_, network, err := net.ParseCIDR(prefix)
+			if err != nil {
+				continue
+			}
+			if !network.Contains(ip) {
+				return fmt.Errorf("iscsi.0.discovery_interfaces.%d.address: %s is not inside the prefix %s of network interface group %s",
+					i, address, prefix, nigName)
+			}
+		}
+	}
+	return nil
+}
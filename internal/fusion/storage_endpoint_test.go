@@ -0,0 +1,150 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Creates an iSCSI endpoint, updates its display name and destroys it
+func TestAccStorageEndpoint_iscsi(t *testing.T) {
+	rNameConfig := acctest.RandomWithPrefix("storage_endpoint_test")
+	rName := "fusion_storage_endpoint." + rNameConfig
+	storageEndpointName := acctest.RandomWithPrefix("test_se")
+	networkInterfaceGroupName := acctest.RandomWithPrefix("test_nig")
+
+	commonConfig := testNetworkInterfaceGroupConfig("nig", networkInterfaceGroupName, "nig display name", "10.21.200.0/24", "10.21.200.1", 1500)
+	iscsi := func(address string) string {
+		return fmt.Sprintf(`
+		iscsi {
+			discovery_interfaces {
+				address                  = "%[1]s"
+				gateway                  = "10.21.200.1"
+				network_interface_groups = [fusion_network_interface_group.nig.name]
+			}
+		}`, address)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckStorageEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: commonConfig + testStorageEndpointConfig(rNameConfig, storageEndpointName, "se display name", iscsi("10.21.200.10/24")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "name", storageEndpointName),
+					resource.TestCheckResourceAttr(rName, "endpoint_type", "iscsi"),
+					resource.TestCheckResourceAttr(rName, "iscsi.0.discovery_interfaces.0.address", "10.21.200.10/24"),
+					resource.TestCheckResourceAttr(rName, "cbs_azure_iscsi.#", "0"),
+					testStorageEndpointExists(rName),
+				),
+			},
+			{
+				Config: commonConfig + testStorageEndpointConfig(rNameConfig, storageEndpointName, "se display name 2", iscsi("10.21.200.10/24")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "display_name", "se display name 2"),
+					testStorageEndpointExists(rName),
+				),
+			},
+			{
+				Config:      commonConfig + testStorageEndpointConfig(rNameConfig, storageEndpointName, "se display name 2", iscsi("10.21.201.10/24")),
+				ExpectError: regexp.MustCompile("is not inside the prefix 10.21.200.0/24"),
+			},
+		},
+	})
+}
+
+func TestAccStorageEndpoint_exactlyOneType(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      testStorageEndpointConfig("se", "se", "se", ""),
+				ExpectError: regexp.MustCompile("one of `cbs_azure_iscsi,iscsi` must be specified"),
+			},
+			{
+				Config: testStorageEndpointConfig("se", "se", "se", `
+		iscsi {
+			discovery_interfaces {
+				address = "10.21.200.10/24"
+			}
+		}
+		cbs_azure_iscsi {
+			load_balancer = "lb"
+		}`),
+				ExpectError: regexp.MustCompile("only one of `cbs_azure_iscsi,iscsi` can be specified"),
+			},
+		},
+	})
+}
+
+func testStorageEndpointExists(rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfStorageEndpoint, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rName)
+		}
+		if tfStorageEndpoint.Type != "fusion_storage_endpoint" {
+			return fmt.Errorf("expected type: fusion_storage_endpoint. Found: %s", tfStorageEndpoint.Type)
+		}
+		attrs := tfStorageEndpoint.Primary.Attributes
+
+		goclientStorageEndpoint, _, err := testAccProvider.Meta().(*hmrest.APIClient).StorageEndpointsApi.GetStorageEndpoint(context.Background(),
+			attrs["region_name"], attrs["availability_zone_name"], attrs["name"], nil)
+		if err != nil {
+			return fmt.Errorf("go client returned error while searching for %s. Error: %s", attrs["name"], err)
+		}
+		if goclientStorageEndpoint.Name != attrs["name"] || goclientStorageEndpoint.DisplayName != attrs["display_name"] ||
+			goclientStorageEndpoint.EndpointType != attrs["endpoint_type"] {
+			return fmt.Errorf("terraform storage endpoint doesnt match goclients storage endpoint")
+		}
+		return nil
+	}
+}
+
+func testCheckStorageEndpointDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*hmrest.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fusion_storage_endpoint" {
+			continue
+		}
+		attrs := rs.Primary.Attributes
+
+		_, resp, err := client.StorageEndpointsApi.GetStorageEndpoint(context.Background(), attrs["region_name"], attrs["availability_zone_name"], attrs["name"], nil)
+		if err != nil && resp.StatusCode == http.StatusNotFound {
+			continue
+		} else {
+			return fmt.Errorf("storage endpoint may still exist. Expected response code 404, got code %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+// endpoint is the HCL of the iscsi or cbs_azure_iscsi block
+func testStorageEndpointConfig(rName, storageEndpointName, displayName, endpoint string) string {
+	return fmt.Sprintf(`
+	resource "fusion_storage_endpoint" "%[1]s" {
+		name                   = "%[2]s"
+		display_name           = "%[3]s"
+		region_name            = "%[4]s"
+		availability_zone_name = "%[5]s"
+		%[6]s
+	}
+	`, rName, storageEndpointName, displayName, region_name, availability_zone_name, endpoint)
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	context "context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+var storageServiceResourceFunctions *BaseResourceFunctions
+
+// Implements ResourceProvider
+type storageServiceProvider struct {
+	BaseResourceProvider
+}
+
+// This is our entry point for the Storage Service resource. Get it movin'
+func resourceStorageService() *schema.Resource {
+	vp := &storageServiceProvider{BaseResourceProvider{ResourceKind: "StorageService"}}
+	storageServiceResourceFunctions = NewBaseResourceFunctions("StorageService", vp)
+
+	storageServiceResourceFunctions.Resource.Schema = map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"hardware_types": {
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Description: "Names of the hardware types supported by this storage service, e.g. `flash-array-x`.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"hardware_type_refs": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The hardware types of this storage service as resolved by Fusion.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"kind": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"self_link": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+	storageServiceResourceFunctions.AddCustomizeDiff(validateStorageServiceHardwareTypes)
+	storageServiceResourceFunctions.AddImmutableAttributes("name")
+
+	return storageServiceResourceFunctions.Resource
+}
+
+func (vp *storageServiceProvider) PrepareCreate(ctx context.Context, d *schema.ResourceData) (InvokeWriteAPI, ResourcePost, error) {
+	name := rdString(ctx, d, "name")
+	displayName := rdStringDefault(ctx, d, "display_name", name)
+
+	body := hmrest.StorageServicePost{
+		Name:          name,
+		DisplayName:   displayName,
+		HardwareTypes: storageServiceHardwareTypes(d.Get("hardware_types").(*schema.Set)),
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.StorageServicesApi.CreateStorageService(ctx, *body.(*hmrest.StorageServicePost), nil)
+		return &op, err
+	}
+	return fn, &body, nil
+}
+
+func (vp *storageServiceProvider) ReadResource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	ss, _, err := client.StorageServicesApi.GetStorageServiceById(ctx, d.Id(), nil)
+	if err != nil {
+		return err
+	}
+
+	hardwareTypes := []string{}
+	hardwareTypeRefs := []map[string]interface{}{}
+	for _, hwType := range ss.HardwareTypes {
+		hardwareTypes = append(hardwareTypes, hwType.Name)
+		hardwareTypeRefs = append(hardwareTypeRefs, map[string]interface{}{
+			"id":        hwType.Id,
+			"name":      hwType.Name,
+			"kind":      hwType.Kind,
+			"self_link": hwType.SelfLink,
+		})
+	}
+	if err := d.Set("hardware_types", hardwareTypes); err != nil {
+		return err
+	}
+	if err := d.Set("hardware_type_refs", hardwareTypeRefs); err != nil {
+		return err
+	}
+
+	d.Set("name", ss.Name)
+	d.Set("display_name", ss.DisplayName)
+	return nil
+}
+
+func (vp *storageServiceProvider) PrepareDelete(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, error) {
+	storageServiceName := rdString(ctx, d, "name")
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.StorageServicesApi.DeleteStorageService(ctx, storageServiceName, nil)
+		return &op, err
+	}
+	return fn, nil
+}
+
+func (vp *storageServiceProvider) PrepareUpdate(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, []ResourcePatch, error) {
+	var patches []ResourcePatch // []*hmrest.StorageServicePatch
+
+	storageServiceName := rdString(ctx, d, "name")
+
+	if d.HasChange("display_name") {
+		displayName := rdString(ctx, d, "display_name")
+		tflog.Info(ctx, "Updating", "display_name", displayName)
+		patches = append(patches, &hmrest.StorageServicePatch{
+			DisplayName: &hmrest.NullableString{Value: displayName},
+		})
+	}
+
+	if d.HasChange("hardware_types") {
+		hardwareTypes := storageServiceHardwareTypes(d.Get("hardware_types").(*schema.Set))
+		tflog.Info(ctx, "Updating", "hardware_types", hardwareTypes)
+		patches = append(patches, &hmrest.StorageServicePatch{
+			HardwareTypes: &hmrest.NullableStringArray{Value: hardwareTypes},
+		})
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.StorageServicesApi.UpdateStorageService(ctx, *body.(*hmrest.StorageServicePatch), storageServiceName, nil)
+		return &op, err
+	}
+	return fn, patches, nil
+}
+
+// validateStorageServiceHardwareTypes makes sure every requested hardware type is known to Fusion,
+// so that a typo is reported by `terraform plan` rather than in the middle of an apply.
+func validateStorageServiceHardwareTypes(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("hardware_types") {
+		return nil
+	}
+	// Refs are resolved by Fusion, so they will change along with the hardware types
+	if err := d.SetNewComputed("hardware_type_refs"); err != nil {
+		return err
+	}
+	if !d.NewValueKnown("hardware_types") {
+		return nil
+	}
+
+	client, ok := m.(*hmrest.APIClient)
+	if !ok || client == nil {
+		return nil
+	}
+
+	available, _, err := client.HardwareTypesApi.ListHardwareTypes(ctx, nil)
+	if err != nil {
+		return err
+	}
+	known := map[string]bool{}
+	knownNames := []string{}
+	for _, hwType := range available.Items {
+		known[hwType.Name] = true
+		knownNames = append(knownNames, hwType.Name)
+	}
+	sort.Strings(knownNames)
+
+	for _, hwType := range storageServiceHardwareTypes(d.Get("hardware_types").(*schema.Set)) {
+		if !known[hwType] {
+			return fmt.Errorf("hardware_types: unknown hardware type %q, must be one of: %s", hwType, strings.Join(knownNames, ", "))
+		}
+	}
+	return nil
+}
+
+func storageServiceHardwareTypes(set *schema.Set) []string {
+	hardwareTypes := []string{}
+	for _, item := range set.List() {
+		hardwareTypes = append(hardwareTypes, item.(string))
+	}
+	sort.Strings(hardwareTypes)
+	return hardwareTypes
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Creates and destroys
+func TestAccStorageService_basic(t *testing.T) {
+	rNameConfig := acctest.RandomWithPrefix("storage_service_test")
+	rName := "fusion_storage_service." + rNameConfig
+	displayName := acctest.RandomWithPrefix("storage-service-display-name")
+	storageServiceName := acctest.RandomWithPrefix("test_ss")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckStorageServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testStorageServiceConfig(rNameConfig, storageServiceName, displayName, []string{"flash-array-x"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "name", storageServiceName),
+					resource.TestCheckResourceAttr(rName, "display_name", displayName),
+					resource.TestCheckResourceAttr(rName, "hardware_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(rName, "hardware_types.*", "flash-array-x"),
+					resource.TestCheckResourceAttr(rName, "hardware_type_refs.#", "1"),
+					resource.TestCheckResourceAttr(rName, "hardware_type_refs.0.name", "flash-array-x"),
+					resource.TestCheckResourceAttrSet(rName, "hardware_type_refs.0.id"),
+					testStorageServiceExists(rName),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Updates display name and hardware types
+func TestAccStorageService_update(t *testing.T) {
+	rNameConfig := acctest.RandomWithPrefix("storage_service_test")
+	rName := "fusion_storage_service." + rNameConfig
+	displayName1 := acctest.RandomWithPrefix("storage-service-display-name")
+	displayName2 := acctest.RandomWithPrefix("storage-service-display-name2")
+	storageServiceName := acctest.RandomWithPrefix("test_ss")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckStorageServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testStorageServiceConfig(rNameConfig, storageServiceName, displayName1, []string{"flash-array-x"}),
+				Check:  testStorageServiceExists(rName),
+			},
+			{
+				Config: testStorageServiceConfig(rNameConfig, storageServiceName, displayName2, []string{"flash-array-x", "flash-array-c"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "display_name", displayName2),
+					resource.TestCheckResourceAttr(rName, "hardware_types.#", "2"),
+					resource.TestCheckResourceAttr(rName, "hardware_type_refs.#", "2"),
+					testStorageServiceExists(rName),
+				),
+			},
+			// Unknown hardware types are caught at plan time
+			{
+				Config:      testStorageServiceConfig(rNameConfig, storageServiceName, displayName2, []string{"flash-array-typo"}),
+				ExpectError: regexp.MustCompile(`unknown hardware type "flash-array-typo"`),
+			},
+		},
+	})
+}
+
+func testStorageServiceExists(rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfStorageService, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rName)
+		}
+		if tfStorageService.Type != "fusion_storage_service" {
+			return fmt.Errorf("expected type: fusion_storage_service. Found: %s", tfStorageService.Type)
+		}
+		attrs := tfStorageService.Primary.Attributes
+
+		goclientStorageService, _, err := testAccProvider.Meta().(*hmrest.APIClient).StorageServicesApi.GetStorageService(context.Background(), attrs["name"], nil)
+		if err != nil {
+			return fmt.Errorf("go client returned error while searching for %s. Error: %s", attrs["name"], err)
+		}
+		if strings.Compare(goclientStorageService.Name, attrs["name"]) != 0 ||
+			strings.Compare(goclientStorageService.DisplayName, attrs["display_name"]) != 0 ||
+			fmt.Sprint(len(goclientStorageService.HardwareTypes)) != attrs["hardware_types.#"] {
+			return fmt.Errorf("terraform storage service doesnt match goclients storage service")
+		}
+		return nil
+	}
+}
+
+func testCheckStorageServiceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*hmrest.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fusion_storage_service" {
+			continue
+		}
+		storageServiceName := rs.Primary.Attributes["name"]
+
+		_, resp, err := client.StorageServicesApi.GetStorageService(context.Background(), storageServiceName, nil)
+		if err != nil && resp.StatusCode == http.StatusNotFound {
+			continue
+		} else {
+			return fmt.Errorf("storage service may still exist. Expected response code 404, got code %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+func testStorageServiceConfig(rName string, storageServiceName string, displayName string, hardwareTypes []string) string {
+	return fmt.Sprintf(`
+	resource "fusion_storage_service" "%[1]s" {
+		name           = "%[2]s"
+		display_name   = "%[3]s"
+		hardware_types = ["%[4]s"]
+	}
+	`, rName, storageServiceName, displayName, strings.Join(hardwareTypes, `", "`))
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	context "context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+var tenantResourceFunctions *BaseResourceFunctions
+
+// Implements ResourceProvider
+type tenantProvider struct {
+	BaseResourceProvider
+}
+
+// This is our entry point for the Tenant resource. Get it movin'
+func resourceTenant() *schema.Resource {
+	vp := &tenantProvider{BaseResourceProvider{ResourceKind: "Tenant"}}
+	tenantResourceFunctions = NewBaseResourceFunctions("Tenant", vp)
+
+	tenantResourceFunctions.Resource.Schema = map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+	}
+	tenantResourceFunctions.AddImmutableAttributes("name")
+
+	return tenantResourceFunctions.Resource
+}
+
+func (vp *tenantProvider) PrepareCreate(ctx context.Context, d *schema.ResourceData) (InvokeWriteAPI, ResourcePost, error) {
+	name := rdString(ctx, d, "name")
+	displayName := rdStringDefault(ctx, d, "display_name", name)
+
+	body := hmrest.TenantPost{
+		Name:        name,
+		DisplayName: displayName,
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.TenantsApi.CreateTenant(ctx, *body.(*hmrest.TenantPost), nil)
+		return &op, err
+	}
+	return fn, &body, nil
+}
+
+func (vp *tenantProvider) ReadResource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	tenant, _, err := client.TenantsApi.GetTenantById(ctx, d.Id(), nil)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", tenant.Name)
+	d.Set("display_name", tenant.DisplayName)
+	return nil
+}
+
+func (vp *tenantProvider) PrepareDelete(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, error) {
+	tenantName := rdString(ctx, d, "name")
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.TenantsApi.DeleteTenant(ctx, tenantName, nil)
+		return &op, err
+	}
+	return fn, nil
+}
+
+func (vp *tenantProvider) PrepareUpdate(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, []ResourcePatch, error) {
+	var patches []ResourcePatch // []*hmrest.TenantPatch
+
+	tenantName := rdString(ctx, d, "name")
+	if d.HasChange("display_name") {
+		displayName := rdString(ctx, d, "display_name")
+		tflog.Info(ctx, "Updating", "display_name", displayName)
+		patches = append(patches, &hmrest.TenantPatch{
+			DisplayName: &hmrest.NullableString{Value: displayName},
+		})
+	}
+
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.TenantsApi.UpdateTenant(ctx, *body.(*hmrest.TenantPatch), tenantName, nil)
+		return &op, err
+	}
+	return fn, patches, nil
+}
@@ -7,7 +7,6 @@ package fusion
 
 import (
 	context "context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -42,6 +41,7 @@ func resourceTenantSpace() *schema.Resource {
 			Computed: true,
 		},
 	}
+	tenantSpaceResourceFunctions.AddImmutableAttributes("name", "tenant_name")
 
 	return tenantSpaceResourceFunctions.Resource
 }
@@ -92,9 +92,7 @@ func (vp *tenantSpaceProvider) PrepareUpdate(ctx context.Context, client *hmrest
 
 	tenant := d.Get("tenant_name").(string)
 	tenantSpaceName := d.Get("name").(string)
-	if d.HasChangeExcept("display_name") {
-		return nil, nil, fmt.Errorf("attempting to update an immutable field")
-	} else {
+	if d.HasChange("display_name") {
 		displayName := d.Get("display_name").(string)
 		tflog.Info(ctx, "Updating", "display_name", displayName)
 		patches = append(patches, &hmrest.TenantSpacePatch{
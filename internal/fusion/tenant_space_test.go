@@ -58,6 +58,9 @@ func TestAccTenantSpace_update(t *testing.T) {
 	rand.Read(buff)
 	displayNameTooBig := base64.StdEncoding.EncodeToString(buff)
 	tenantSpaceName := acctest.RandomWithPrefix("test_ts")
+	tenantSpaceName2 := acctest.RandomWithPrefix("test_ts")
+	otherTenantName := acctest.RandomWithPrefix("test_tenant")
+	var tenantSpaceId string
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
@@ -80,6 +83,7 @@ func TestAccTenantSpace_update(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(rName, "display_name", displayName2),
 					testTenantSpaceExists(rName),
+					testResourceId(rName, &tenantSpaceId),
 				),
 			},
 			// Bad display name values
@@ -92,22 +96,29 @@ func TestAccTenantSpace_update(t *testing.T) {
 				ExpectError: regexp.MustCompile("display_name must be at most 256 characters"),
 			},
 
-			// TODO: HM-2419
-			//Can't update certain values
+			// Changing the name replaces the tenant space
 			{
-				Config:      testTenantSpaceConfig(rNameConfig, displayName1, "immutable", testAccTenant),
-				ExpectError: regexp.MustCompile("attempting to update an immutable field"),
-			},
-			{
-				Config:      testTenantSpaceConfig(rNameConfig, displayName1, tenantSpaceName, "immutable"),
-				ExpectError: regexp.MustCompile("attempting to update an immutable field"),
+				Config: testTenantSpaceConfig(rNameConfig, displayName2, tenantSpaceName2, testAccTenant),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "name", tenantSpaceName2),
+					testTenantSpaceExists(rName),
+					testResourceReplaced(rName, &tenantSpaceId),
+					testResourceId(rName, &tenantSpaceId),
+				),
 			},
-			// When the test tries to destroy the resources at the end, it does not do a refresh first,
-			// and therefore the destroy will fail if the state is invalid. Because of this, we need to manually
-			// return the state to a valid config. Note that the "terraform destroy" command does do
-			// a refresh first, so this issue only applies to acceptance tests.
+			// Changing the tenant replaces the tenant space too
 			{
-				Config: testTenantSpaceConfig(rNameConfig, displayName1, tenantSpaceName, testAccTenant),
+				Config: testTenantConfig("other", otherTenantName, otherTenantName) + fmt.Sprintf(`
+				resource "fusion_tenant_space" "%[1]s" {
+					name         = "%[2]s"
+					display_name = "%[3]s"
+					tenant_name  = fusion_tenant.other.name
+				}
+				`, rNameConfig, tenantSpaceName2, displayName2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "tenant_name", otherTenantName),
+					testResourceReplaced(rName, &tenantSpaceId),
+				),
 			},
 		},
 	})
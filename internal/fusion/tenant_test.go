@@ -0,0 +1,146 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Creates and destroys
+func TestAccTenant_basic(t *testing.T) {
+	rNameConfig := acctest.RandomWithPrefix("tenant_test")
+	rName := "fusion_tenant." + rNameConfig
+	displayName := acctest.RandomWithPrefix("tenant-display-name")
+	tenantName := acctest.RandomWithPrefix("test_tenant")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckTenantDestroy,
+		Steps: []resource.TestStep{
+			// Create Tenant and validate it's fields
+			{
+				Config: testTenantConfig(rNameConfig, tenantName, displayName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "name", tenantName),
+					resource.TestCheckResourceAttr(rName, "display_name", displayName),
+					testTenantExists(rName),
+				),
+			},
+			// Import it back by id
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Updates display name
+func TestAccTenant_update(t *testing.T) {
+	rNameConfig := acctest.RandomWithPrefix("tenant_test")
+	rName := "fusion_tenant." + rNameConfig
+	displayName1 := acctest.RandomWithPrefix("tenant-display-name")
+	displayName2 := acctest.RandomWithPrefix("tenant-display-name2")
+	tenantName := acctest.RandomWithPrefix("test_tenant")
+	tenantName2 := acctest.RandomWithPrefix("test_tenant")
+	var tenantId string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckTenantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testTenantConfig(rNameConfig, tenantName, displayName1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "display_name", displayName1),
+					testTenantExists(rName),
+				),
+			},
+			// Update the display name, assert that the tf resource got updated, then assert the backend shows the same
+			{
+				Config: testTenantConfig(rNameConfig, tenantName, displayName2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "display_name", displayName2),
+					testTenantExists(rName),
+					testResourceId(rName, &tenantId),
+				),
+			},
+			// Changing the name replaces the tenant
+			{
+				Config: testTenantConfig(rNameConfig, tenantName2, displayName2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "name", tenantName2),
+					testTenantExists(rName),
+					testResourceReplaced(rName, &tenantId),
+				),
+			},
+		},
+	})
+}
+
+func testTenantExists(rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfTenant, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rName)
+		}
+		if tfTenant.Type != "fusion_tenant" {
+			return fmt.Errorf("expected type: fusion_tenant. Found: %s", tfTenant.Type)
+		}
+		attrs := tfTenant.Primary.Attributes
+
+		goclientTenant, _, err := testAccProvider.Meta().(*hmrest.APIClient).TenantsApi.GetTenant(context.Background(), attrs["name"], nil)
+		if err != nil {
+			return fmt.Errorf("go client returned error while searching for %s. Error: %s", attrs["name"], err)
+		}
+		if strings.Compare(goclientTenant.Name, attrs["name"]) != 0 ||
+			strings.Compare(goclientTenant.DisplayName, attrs["display_name"]) != 0 {
+			return fmt.Errorf("terraform tenant doesnt match goclients tenant")
+		}
+		return nil
+	}
+}
+
+func testCheckTenantDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*hmrest.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fusion_tenant" {
+			continue
+		}
+		tenantName := rs.Primary.Attributes["name"]
+
+		_, resp, err := client.TenantsApi.GetTenant(context.Background(), tenantName, nil)
+		if err != nil && resp.StatusCode == http.StatusNotFound {
+			continue
+		} else {
+			return fmt.Errorf("tenant may still exist. Expected response code 404, got code %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+func testTenantConfig(rName string, tenantName string, displayName string) string {
+	return fmt.Sprintf(`
+	resource "fusion_tenant" "%[1]s" {
+		name          = "%[2]s"
+		display_name  = "%[3]s"
+	}
+	`, rName, tenantName, displayName)
+}
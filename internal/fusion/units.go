@@ -0,0 +1,141 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Sizes and bandwidths use binary multipliers (1K = 1024), IOPS use decimal ones (1K = 1000).
+var binaryUnits = []string{"", "K", "M", "G", "T", "P", "E"}
+var decimalUnits = []string{"", "K", "M", "G"}
+
+// parseSize parses a human-readable size such as "512G" or "10T" into bytes.
+// A bare number is taken as bytes.
+func parseSize(s string) (int64, error) {
+	v := strings.TrimSpace(s)
+	v = strings.TrimSuffix(strings.TrimSuffix(v, "B"), "i")
+	return parseUnits(s, v, binaryUnits, 1024)
+}
+
+// parseBandwidth parses a human-readable bandwidth such as "500M/s" or "1G" into bytes per second.
+func parseBandwidth(s string) (int64, error) {
+	v := strings.TrimSpace(s)
+	v = strings.TrimSpace(strings.TrimSuffix(v, "/s"))
+	v = strings.TrimSuffix(strings.TrimSuffix(v, "B"), "i")
+	return parseUnits(s, v, binaryUnits, 1024)
+}
+
+// parseIops parses a human-readable IOPS value such as "100K IOPS" or "2M".
+func parseIops(s string) (int64, error) {
+	v := strings.TrimSpace(s)
+	if len(v) >= 4 && strings.EqualFold(v[len(v)-4:], "iops") {
+		v = strings.TrimSpace(v[:len(v)-4])
+	}
+	return parseUnits(s, v, decimalUnits, 1000)
+}
+
+func parseUnits(original, v string, units []string, base int64) (int64, error) {
+	if v == "" {
+		return 0, fmt.Errorf("empty value")
+	}
+
+	multiplier := int64(1)
+	number := v
+	suffix := strings.ToUpper(v[len(v)-1:])
+	if suffix < "0" || suffix > "9" {
+		number = strings.TrimSpace(v[:len(v)-1])
+		found := false
+		for _, unit := range units[1:] {
+			multiplier *= base
+			if unit == suffix {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("unknown unit in %q, must be one of %s", original, strings.Join(units[1:], ", "))
+		}
+	}
+
+	n, err := strconv.ParseInt(number, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("cannot parse %q: expected a whole number optionally followed by a unit", original)
+	}
+	if n <= 0 {
+		return 0, fmt.Errorf("%q must be greater than zero", original)
+	}
+	if n > (1<<63-1)/multiplier {
+		return 0, fmt.Errorf("%q is too large", original)
+	}
+	return n * multiplier, nil
+}
+
+// formatSize renders bytes using the largest binary unit that represents the value exactly.
+func formatSize(v int64) string {
+	return formatUnits(v, binaryUnits, 1024)
+}
+
+func formatBandwidth(v int64) string {
+	return formatUnits(v, binaryUnits, 1024) + "/s"
+}
+
+func formatIops(v int64) string {
+	return formatUnits(v, decimalUnits, 1000)
+}
+
+func formatUnits(v int64, units []string, base int64) string {
+	i := 0
+	for v != 0 && v%base == 0 && i < len(units)-1 {
+		v /= base
+		i++
+	}
+	return fmt.Sprintf("%d%s", v, units[i])
+}
+
+// validateUnits adapts one of the parse functions above to a ValidateDiagFunc.
+func validateUnits(parse func(string) (int64, error)) schema.SchemaValidateDiagFunc {
+	return func(val interface{}, p cty.Path) diag.Diagnostics {
+		if _, err := parse(val.(string)); err != nil {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Invalid value",
+				Detail:        err.Error(),
+				AttributePath: p,
+			}}
+		}
+		return nil
+	}
+}
+
+// suppressEquivalentUnits hides diffs between two spellings of the same quantity, e.g. "1T" and "1024G".
+func suppressEquivalentUnits(parse func(string) (int64, error)) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		oldValue, err := parse(old)
+		if err != nil {
+			return false
+		}
+		newValue, err := parse(new)
+		if err != nil {
+			return false
+		}
+		return oldValue == newValue
+	}
+}
+
+// setUnitsAttr stores value in the given attribute, keeping the user's spelling when it is equivalent.
+func setUnitsAttr(d *schema.ResourceData, key string, value int64, parse func(string) (int64, error), format func(int64) string) error {
+	if current, err := parse(d.Get(key).(string)); err == nil && current == value {
+		return nil
+	}
+	return d.Set(key, format(value))
+}
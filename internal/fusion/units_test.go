@@ -0,0 +1,81 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"testing"
+)
+
+func TestParseUnits(t *testing.T) {
+	cases := []struct {
+		parse    func(string) (int64, error)
+		input    string
+		expected int64
+	}{
+		{parseSize, "1048576", 1 << 20},
+		{parseSize, "512G", 512 << 30},
+		{parseSize, "10T", 10 << 40},
+		{parseSize, "10TB", 10 << 40},
+		{parseSize, "10TiB", 10 << 40},
+		{parseSize, "4p", 4 << 50},
+		{parseBandwidth, "500M/s", 500 << 20},
+		{parseBandwidth, "1G", 1 << 30},
+		{parseBandwidth, "512 GB/s", 512 << 30},
+		{parseIops, "100K IOPS", 100000},
+		{parseIops, "100k iops", 100000},
+		{parseIops, "2M", 2000000},
+		{parseIops, "250", 250},
+	}
+	for _, c := range cases {
+		got, err := c.parse(c.input)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", c.input, err)
+		} else if got != c.expected {
+			t.Errorf("%q: expected %d, got %d", c.input, c.expected, got)
+		}
+	}
+}
+
+func TestParseUnitsInvalid(t *testing.T) {
+	cases := []struct {
+		parse func(string) (int64, error)
+		input string
+	}{
+		{parseSize, ""},
+		{parseSize, "G"},
+		{parseSize, "10X"},
+		{parseSize, "1.5T"},
+		{parseSize, "-1G"},
+		{parseSize, "0"},
+		{parseSize, "9E"},
+		{parseIops, "10T"},
+		{parseBandwidth, "fast"},
+	}
+	for _, c := range cases {
+		if got, err := c.parse(c.input); err == nil {
+			t.Errorf("%q: expected error, got %d", c.input, got)
+		}
+	}
+}
+
+func TestFormatUnits(t *testing.T) {
+	cases := []struct {
+		got      string
+		expected string
+	}{
+		{formatSize(10 << 40), "10T"},
+		{formatSize(1536 << 20), "1536M"},
+		{formatSize(1000), "1000"},
+		{formatBandwidth(500 << 20), "500M/s"},
+		{formatIops(100000), "100K"},
+		{formatIops(100000000), "100M"},
+	}
+	for _, c := range cases {
+		if c.got != c.expected {
+			t.Errorf("expected %q, got %q", c.expected, c.got)
+		}
+	}
+}
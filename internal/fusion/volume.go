@@ -7,10 +7,17 @@ package fusion
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/utilities"
 	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
@@ -18,6 +25,11 @@ import (
 
 var volumeResourceFunctions *BaseResourceFunctions
 
+const (
+	volumeDeletionModeDestroy   = "destroy"
+	volumeDeletionModeEradicate = "eradicate"
+)
+
 // This is our entry point for the Volume resource. Get it movin'
 func resourceVolume() *schema.Resource {
 	vp := &volumeProvider{BaseResourceProvider{ResourceKind: "Volume"}}
@@ -34,8 +46,11 @@ func resourceVolume() *schema.Resource {
 			Computed: true,
 		},
 		"size": {
-			Type:     schema.TypeInt,
-			Required: true,
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      "Size in bytes, unit suffixes such as `512G` or `1T` are accepted. Volumes can only be extended",
+			ValidateDiagFunc: validateUnits(parseSize),
+			DiffSuppressFunc: suppressEquivalentUnits(parseSize),
 		},
 		"tenant_name": {
 			Type:     schema.TypeString,
@@ -65,6 +80,41 @@ func resourceVolume() *schema.Resource {
 				Type: schema.TypeString,
 			},
 		},
+		"source_volume_snapshot": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ConflictsWith:    []string{"source_volume"},
+			ValidateDiagFunc: validateVolumeSnapshotRef,
+			Description: "Volume snapshot to copy data from, either its self_link or `<snapshot name>/<volume snapshot name>` " +
+				"within the same tenant space. WARNING: Changing this value on a volume created from a source overwrites its contents, " +
+				"setting it on a volume which had no source, e.g. an imported one, only records it",
+		},
+		"source_volume": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"source_volume_snapshot"},
+			Description: "Volume to copy data from, either its self_link or its name within the same tenant space. " +
+				"WARNING: Changing this value on a volume created from a source overwrites its contents, " +
+				"setting it on a volume which had no source, e.g. an imported one, only records it",
+		},
+		"deletion_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      volumeDeletionModeEradicate,
+			ValidateFunc: validation.StringInSlice([]string{volumeDeletionModeDestroy, volumeDeletionModeEradicate}, false),
+			Description: "`eradicate` deletes the volume permanently. `destroy` only marks it as destroyed, so that it can " +
+				"be recovered until time_remaining runs out; creating a volume with the same name recovers it with its contents, " +
+				"`source_volume_snapshot` and `source_volume` are not applied to it",
+		},
+		"destroyed": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"time_remaining": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Milliseconds left until a destroyed volume is eradicated",
+		},
 		"created_at": {
 			Type:     schema.TypeInt,
 			Computed: true,
@@ -86,9 +136,104 @@ func resourceVolume() *schema.Resource {
 		},
 	}
 
+	volumeResourceFunctions.AddCustomizeDiff(validateVolumeSize)
+
+	// Version 0 stored size as a number of bytes
+	volumeResourceFunctions.Resource.SchemaVersion = 1
+	volumeResourceFunctions.Resource.UseJSONNumber = true
+	volumeResourceFunctions.Resource.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    resourceVolumeV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: upgradeVolumeStateV0,
+		},
+	}
+	volumeResourceFunctions.AddImmutableAttributes("name", "tenant_name", "tenant_space_name")
+
 	return volumeResourceFunctions.Resource
 }
 
+// resourceVolumeV0 is the schema of fusion_volume before size accepted unit suffixes
+func resourceVolumeV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"tenant_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tenant_space_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"storage_class_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"placement_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"protection_policy_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"host_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"serial_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_iscsi_iqn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_iscsi_addresses": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// upgradeVolumeStateV0 turns the size in bytes into a string, which parseSize reads back as bytes
+func upgradeVolumeStateV0(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	switch size := rawState["size"].(type) {
+	case json.Number:
+		rawState["size"] = size.String()
+	case float64:
+		rawState["size"] = strconv.FormatInt(int64(size), 10)
+	case nil, string:
+	default:
+		return nil, fmt.Errorf("unexpected size %v of type %T in the fusion_volume state", size, size)
+	}
+	return rawState, nil
+}
+
 // Implements ResourceProvider
 type volumeProvider struct {
 	BaseResourceProvider
@@ -99,18 +244,50 @@ func (vp *volumeProvider) PrepareCreate(ctx context.Context, d *schema.ResourceD
 	tenantSpaceName := rdString(ctx, d, "tenant_space_name")
 	name := rdString(ctx, d, "name")
 	displayName := rdStringDefault(ctx, d, "display_name", name)
+	size, err := parseSize(rdString(ctx, d, "size"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("size: %w", err)
+	}
 
 	body := hmrest.VolumePost{
 		Name:             name,
 		DisplayName:      displayName,
-		Size:             int64(rdInt(d, "size")),
+		Size:             size,
 		StorageClass:     rdString(ctx, d, "storage_class_name"),
 		PlacementGroup:   rdString(ctx, d, "placement_group_name"),
 		ProtectionPolicy: rdString(ctx, d, "protection_policy_name"),
 	}
 
+	sourceVolumeSnapshot := rdString(ctx, d, "source_volume_snapshot")
+	sourceVolume := rdString(ctx, d, "source_volume")
+
 	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
-		op, _, err := client.VolumesApi.CreateVolume(ctx, *body.(*hmrest.VolumePost), tenantName, tenantSpaceName, nil)
+		post := body.(*hmrest.VolumePost)
+
+		// A destroyed volume with the same name is brought back rather than replaced by an empty one,
+		// PrepareCreatePatches then brings its other arguments in line
+		existing, resp, err := client.VolumesApi.GetVolume(ctx, tenantName, tenantSpaceName, post.Name, nil)
+		if err == nil && existing.Destroyed {
+			return recoverVolume(ctx, client, &existing, post)
+		} else if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+			return nil, err
+		}
+
+		// Sources may be given by name, which can only be resolved once we have a client
+		if sourceVolumeSnapshot != "" {
+			link, err := resolveVolumeSnapshotLink(ctx, client, tenantName, tenantSpaceName, sourceVolumeSnapshot)
+			if err != nil {
+				return nil, err
+			}
+			post.SourceLink = link
+		} else if sourceVolume != "" {
+			link, err := resolveVolumeLink(ctx, client, tenantName, tenantSpaceName, sourceVolume)
+			if err != nil {
+				return nil, err
+			}
+			post.SourceLink = link
+		}
+		op, _, err := client.VolumesApi.CreateVolume(ctx, *post, tenantName, tenantSpaceName, nil)
 		return &op, err
 	}
 	return fn, &body, nil
@@ -137,9 +314,13 @@ func (vp *volumeProvider) ReadResource(ctx context.Context, client *hmrest.APICl
 	d.Set("placement_group_name", vol.PlacementGroup.Name)
 	d.Set("name", vol.Name)
 	d.Set("display_name", vol.DisplayName)
-	d.Set("size", vol.Size)
+	if err := setUnitsAttr(d, "size", vol.Size, parseSize, formatSize); err != nil {
+		return err
+	}
 	d.Set("serial_number", vol.SerialNumber)
 	d.Set("created_at", vol.CreatedAt)
+	d.Set("destroyed", vol.Destroyed)
+	d.Set("time_remaining", vol.TimeRemaining)
 	if vol.ProtectionPolicy != nil {
 		d.Set("protection_policy_name", vol.ProtectionPolicy.Name)
 	}
@@ -156,7 +337,7 @@ func (vp *volumeProvider) ReadResource(ctx context.Context, client *hmrest.APICl
 //
 // If a new size is provided, it must be larger than the current size.  Only
 // extending volumes is supported at this time, since truncating volumes can
-// lead to data loss.
+// lead to data loss. validateVolumeSize rejects anything else at plan time.
 func (vp *volumeProvider) PrepareUpdate(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, []ResourcePatch, error) {
 	volumeName := d.Get("name").(string)
 	tenantSpaceName := d.Get("tenant_space_name").(string)
@@ -232,13 +413,7 @@ func (vp *volumeProvider) PrepareUpdate(ctx context.Context, client *hmrest.APIC
 	}
 
 	if d.HasChange("host_names") || reAddHosts {
-		s := ""
-		for idx, item := range d.Get("host_names").(*schema.Set).List() {
-			if idx != 0 {
-				s += ","
-			}
-			s += item.(string)
-		}
+		s := volumeHostNames(d)
 		tflog.Trace(ctx, "update",
 			"resource", "volume",
 			"parameter", "host_names",
@@ -251,8 +426,51 @@ func (vp *volumeProvider) PrepareUpdate(ctx context.Context, client *hmrest.APIC
 		})
 	}
 
+	// Refreshing the contents from a new source comes before any resize, so that a larger
+	// size asked for in the same apply is applied to the new contents.
+	// The source isn't read back, so a volume without one in its state, e.g. an imported one,
+	// only records the new value instead of having its contents overwritten.
+	oldSourceVolumeSnapshot, _ := d.GetChange("source_volume_snapshot")
+	oldSourceVolume, _ := d.GetChange("source_volume")
+	hadSource := oldSourceVolumeSnapshot.(string) != "" || oldSourceVolume.(string) != ""
+
+	if hadSource && d.HasChange("source_volume_snapshot") && d.Get("source_volume_snapshot").(string) != "" {
+		link, err := resolveVolumeSnapshotLink(ctx, client, tenantName, tenantSpaceName, d.Get("source_volume_snapshot").(string))
+		if err != nil {
+			return nil, nil, err
+		}
+		tflog.Trace(ctx, "update",
+			"resource", "volume",
+			"parameter", "source_volume_snapshot",
+			"to", link,
+			"patch_idx", len(patches),
+		)
+		patches = append(patches, &hmrest.VolumePatch{
+			SourceVolumeSnapshotLink: &hmrest.NullableString{Value: link},
+		})
+	}
+
+	if hadSource && d.HasChange("source_volume") && d.Get("source_volume").(string) != "" {
+		link, err := resolveVolumeLink(ctx, client, tenantName, tenantSpaceName, d.Get("source_volume").(string))
+		if err != nil {
+			return nil, nil, err
+		}
+		tflog.Trace(ctx, "update",
+			"resource", "volume",
+			"parameter", "source_volume",
+			"to", link,
+			"patch_idx", len(patches),
+		)
+		patches = append(patches, &hmrest.VolumePatch{
+			SourceLink: &hmrest.NullableString{Value: link},
+		})
+	}
+
 	if d.HasChange("size") {
-		size := d.Get("size").(int)
+		size, err := parseSize(d.Get("size").(string))
+		if err != nil {
+			return nil, nil, fmt.Errorf("size: %w", err)
+		}
 		tflog.Trace(ctx, "update",
 			"resource", "volume",
 			"parameter", "size",
@@ -261,7 +479,7 @@ func (vp *volumeProvider) PrepareUpdate(ctx context.Context, client *hmrest.APIC
 		)
 
 		patches = append(patches, &hmrest.VolumePatch{
-			Size: &hmrest.NullableSize{Value: int64(size)},
+			Size: &hmrest.NullableSize{Value: size},
 		})
 	}
 	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
@@ -272,10 +490,13 @@ func (vp *volumeProvider) PrepareUpdate(ctx context.Context, client *hmrest.APIC
 	return fn, patches, nil
 }
 
+// volumeProvider.PrepareDelete removes all host assignments and then, depending on deletion_mode,
+// either eradicates the volume or only marks it destroyed so that it can still be recovered.
 func (vp *volumeProvider) PrepareDelete(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, error) {
 	volumeName := d.Get("name").(string)
 	tenantSpaceName := d.Get("tenant_space_name").(string)
 	tenantName := d.Get("tenant_name").(string)
+	deletionMode := d.Get("deletion_mode").(string)
 
 	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
 		tflog.Trace(ctx, "removing host assignments before deleting volume")
@@ -296,8 +517,228 @@ func (vp *volumeProvider) PrepareDelete(ctx context.Context, client *hmrest.APIC
 		}
 		tflog.Trace(ctx, "done removing host assignments")
 
+		if deletionMode == volumeDeletionModeDestroy {
+			tflog.Info(ctx, "destroying volume, it can be recovered until it is eradicated", "volume_name", volumeName)
+			op, _, err = client.VolumesApi.UpdateVolume(ctx, hmrest.VolumePatch{
+				Destroyed: &hmrest.NullableBoolean{Value: true},
+			}, tenantName, tenantSpaceName, volumeName, nil)
+			return &op, err
+		}
+
 		op, _, err = client.VolumesApi.DeleteVolume(ctx, tenantName, tenantSpaceName, volumeName, nil)
 		return &op, err
 	}
 	return fn, nil
 }
+
+// recoverVolume clears the destroyed flag of an existing volume, keeping its contents. The returned
+// operation has already completed.
+func recoverVolume(ctx context.Context, client *hmrest.APIClient, existing *hmrest.Volume, post *hmrest.VolumePost) (*hmrest.Operation, error) {
+	if post.Size < existing.Size {
+		return nil, fmt.Errorf("cannot recover destroyed volume %s: its size %d is larger than the requested size %d",
+			existing.Name, existing.Size, post.Size)
+	}
+
+	tflog.Info(ctx, "recovering destroyed volume", "volume_name", existing.Name, "time_remaining", existing.TimeRemaining)
+	op, err := client.VolumesApi.RecoverVolume(ctx, existing.Tenant.Name, existing.TenantSpace.Name, existing.Name, "")
+	utilities.TraceError(ctx, err)
+	if err != nil {
+		return nil, err
+	}
+	succeeded, err := utilities.WaitOnOperation(ctx, &op, client)
+	if err != nil {
+		return &op, err
+	}
+	if !succeeded {
+		tflog.Error(ctx, "failed recovering volume")
+		return &op, fmt.Errorf("failed to recover destroyed volume %s", existing.Name)
+	}
+	if op.Result == nil || op.Result.Resource == nil {
+		op.Result = &hmrest.OperationResult{Resource: &hmrest.ResourceReference{Id: existing.Id, Name: existing.Name}}
+	}
+	return &op, nil
+}
+
+// Host access policies can't be set on creation, and a recovered volume keeps whatever it had when it was
+// destroyed, so the volume is patched to match its arguments once its id is saved. The sources are left out,
+// a recovered volume keeps its contents.
+func (vp *volumeProvider) PrepareCreatePatches(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) (InvokeWriteAPI, []ResourcePatch, error) {
+	vol, _, err := client.VolumesApi.GetVolumeById(ctx, d.Id(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	size, err := parseSize(rdString(ctx, d, "size"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("size: %w", err)
+	}
+	displayName := rdStringDefault(ctx, d, "display_name", vol.Name)
+	protectionPolicyName := rdString(ctx, d, "protection_policy_name")
+	storageClassName := rdString(ctx, d, "storage_class_name")
+	placementGroupName := rdString(ctx, d, "placement_group_name")
+
+	var patches []ResourcePatch // []*hmrest.VolumePatch
+	if displayName != vol.DisplayName {
+		patches = append(patches, &hmrest.VolumePatch{DisplayName: &hmrest.NullableString{Value: displayName}})
+	}
+	currentProtectionPolicyName := ""
+	if vol.ProtectionPolicy != nil {
+		currentProtectionPolicyName = vol.ProtectionPolicy.Name
+	}
+	if protectionPolicyName != currentProtectionPolicyName {
+		patches = append(patches, &hmrest.VolumePatch{ProtectionPolicy: &hmrest.NullableString{Value: protectionPolicyName}})
+	}
+	if vol.StorageClass == nil || storageClassName != vol.StorageClass.Name || vol.PlacementGroup == nil || placementGroupName != vol.PlacementGroup.Name {
+		patches = append(patches, &hmrest.VolumePatch{
+			StorageClass:   &hmrest.NullableString{Value: storageClassName},
+			PlacementGroup: &hmrest.NullableString{Value: placementGroupName},
+		})
+	}
+	if size > vol.Size {
+		patches = append(patches, &hmrest.VolumePatch{Size: &hmrest.NullableSize{Value: size}})
+	}
+	currentHostNames := schema.NewSet(schema.HashString, nil)
+	for _, hap := range vol.HostAccessPolicies {
+		currentHostNames.Add(hap.Name)
+	}
+	if !currentHostNames.Equal(d.Get("host_names")) {
+		patches = append(patches, &hmrest.VolumePatch{HostAccessPolicies: &hmrest.NullableString{Value: volumeHostNames(d)}})
+	}
+
+	tenantName, tenantSpaceName, volumeName := vol.Tenant.Name, vol.TenantSpace.Name, vol.Name
+	fn := func(ctx context.Context, client *hmrest.APIClient, body RequestSpec) (*hmrest.Operation, error) {
+		op, _, err := client.VolumesApi.UpdateVolume(ctx, *body.(*hmrest.VolumePatch), tenantName, tenantSpaceName, volumeName, nil)
+		return &op, err
+	}
+	return fn, patches, nil
+}
+
+// validateVolumeSize rejects shrinking a volume and sizes above the storage class's size_limit,
+// so that the apply doesn't fail halfway through, after other patches were already applied.
+func validateVolumeSize(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !(d.HasChange("size") || d.HasChange("storage_class_name")) || !d.NewValueKnown("size") {
+		return nil
+	}
+	oldValue, newValue := d.GetChange("size")
+	newSize, err := parseSize(newValue.(string))
+	if err != nil {
+		return fmt.Errorf("size: %w", err)
+	}
+	if d.Id() != "" {
+		if oldSize, err := parseSize(oldValue.(string)); err == nil && newSize < oldSize {
+			return fmt.Errorf("size: volumes can only be extended, cannot shrink %s from %s to %s",
+				d.Get("name").(string), formatSize(oldSize), formatSize(newSize))
+		}
+	}
+
+	if !d.NewValueKnown("storage_class_name") || !d.NewValueKnown("placement_group_name") ||
+		!d.NewValueKnown("tenant_name") || !d.NewValueKnown("tenant_space_name") {
+		return nil
+	}
+	client, ok := m.(*hmrest.APIClient)
+	if !ok || client == nil {
+		return nil
+	}
+	storageClassName := d.Get("storage_class_name").(string)
+	storageClass, err := findVolumeStorageClass(ctx, client, d.Get("tenant_name").(string), d.Get("tenant_space_name").(string),
+		d.Get("placement_group_name").(string), storageClassName)
+	if err != nil {
+		return err
+	}
+	// The placement group or storage class may be created in the same apply, it is checked again by Fusion then
+	if storageClass == nil {
+		tflog.Debug(ctx, "storage class not found, skipping size_limit check", "storage_class_name", storageClassName)
+		return nil
+	}
+	if newSize > storageClass.SizeLimit {
+		return fmt.Errorf("size: %s exceeds the size_limit %s of storage class %s",
+			formatSize(newSize), formatSize(storageClass.SizeLimit), storageClassName)
+	}
+	return nil
+}
+
+// findVolumeStorageClass looks a storage class up in the storage service of the volume's placement group, storage class
+// names are only unique within a storage service. It returns nil if the placement group or storage class doesn't exist.
+func findVolumeStorageClass(ctx context.Context, client *hmrest.APIClient, tenantName, tenantSpaceName, placementGroupName, storageClassName string) (*hmrest.StorageClass, error) {
+	pg, resp, err := client.PlacementGroupsApi.GetPlacementGroup(ctx, tenantName, tenantSpaceName, placementGroupName, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if pg.StorageService == nil {
+		return nil, nil
+	}
+	sc, resp, err := client.StorageClassesApi.GetStorageClass(ctx, pg.StorageService.Name, storageClassName, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &sc, nil
+}
+
+// volumeHostNames joins host_names the way the host_access_policies patch expects them.
+func volumeHostNames(d *schema.ResourceData) string {
+	s := ""
+	for idx, item := range d.Get("host_names").(*schema.Set).List() {
+		if idx != 0 {
+			s += ","
+		}
+		s += item.(string)
+	}
+	return s
+}
+
+// resolveVolumeSnapshotLink turns either a self_link or "<snapshot>/<volume snapshot>" into a self_link.
+func resolveVolumeSnapshotLink(ctx context.Context, client *hmrest.APIClient, tenantName, tenantSpaceName, ref string) (string, error) {
+	parts, err := parseVolumeSnapshotRef(ref)
+	if err != nil || len(parts) == 1 {
+		return ref, err
+	}
+	volumeSnapshot, _, err := client.VolumeSnapshotsApi.GetVolumeSnapshot(ctx, tenantName, tenantSpaceName, parts[0], parts[1], nil)
+	if err != nil {
+		return "", err
+	}
+	return volumeSnapshot.SelfLink, nil
+}
+
+// parseVolumeSnapshotRef splits "<snapshot>/<volume snapshot>" in two, a self_link is returned whole
+func parseVolumeSnapshotRef(ref string) ([]string, error) {
+	if strings.HasPrefix(ref, "/") {
+		return []string{ref}, nil
+	}
+	parts := strings.Split(ref, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("source_volume_snapshot must be a self_link or <snapshot name>/<volume snapshot name>, got %q", ref)
+	}
+	return parts, nil
+}
+
+func validateVolumeSnapshotRef(val interface{}, p cty.Path) diag.Diagnostics {
+	if val.(string) == "" {
+		return nil
+	}
+	if _, err := parseVolumeSnapshotRef(val.(string)); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid value",
+			Detail:        err.Error(),
+			AttributePath: p,
+		}}
+	}
+	return nil
+}
+
+// resolveVolumeLink turns either a self_link or a volume name into a self_link.
+func resolveVolumeLink(ctx context.Context, client *hmrest.APIClient, tenantName, tenantSpaceName, ref string) (string, error) {
+	if strings.HasPrefix(ref, "/") {
+		return ref, nil
+	}
+	volume, _, err := client.VolumesApi.GetVolume(ctx, tenantName, tenantSpaceName, ref, nil)
+	if err != nil {
+		return "", err
+	}
+	return volume.SelfLink, nil
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Implements DataSourceProvider
+type volumeDataSourceProvider struct{}
+
+// This is our entry point for the Volume data source
+func dataSourceVolume() *schema.Resource {
+	ds := NewBaseDataSourceFunctions("Volume", &volumeDataSourceProvider{})
+
+	ds.Resource.Schema = volumeDataSourceSchema()
+	ds.Resource.Schema["id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "name", "serial_number"},
+	}
+	ds.Resource.Schema["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "name", "serial_number"},
+		RequiredWith: []string{"tenant_name", "tenant_space_name"},
+	}
+	ds.Resource.Schema["serial_number"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "name", "serial_number"},
+		Description:  "Searches every tenant space the caller can see, unless tenant_name and tenant_space_name are set.",
+	}
+	ds.Resource.Schema["tenant_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		RequiredWith: []string{"tenant_space_name"},
+	}
+	ds.Resource.Schema["tenant_space_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		RequiredWith: []string{"tenant_name"},
+	}
+
+	return ds.Resource
+}
+
+func (ds *volumeDataSourceProvider) ReadDataSource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	tenantName := rdString(ctx, d, "tenant_name")
+	tenantSpaceName := rdString(ctx, d, "tenant_space_name")
+
+	var vol hmrest.Volume
+	var err error
+	if id := rdString(ctx, d, "id"); id != "" {
+		vol, _, err = client.VolumesApi.GetVolumeById(ctx, id, nil)
+	} else if name := rdString(ctx, d, "name"); name != "" {
+		vol, _, err = client.VolumesApi.GetVolume(ctx, tenantName, tenantSpaceName, name, nil)
+	} else {
+		vol, err = findVolumeBySerialNumber(ctx, client, tenantName, tenantSpaceName, rdString(ctx, d, "serial_number"))
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId(vol.Id)
+	for key, value := range flattenVolume(vol) {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func findVolumeBySerialNumber(ctx context.Context, client *hmrest.APIClient, tenantName, tenantSpaceName, serialNumber string) (hmrest.Volume, error) {
+	var volumes hmrest.VolumeList
+	var err error
+	if tenantName != "" {
+		volumes, _, err = client.VolumesApi.ListVolumes(ctx, tenantName, tenantSpaceName, &hmrest.VolumesApiListVolumesOpts{
+			SerialNumber: optional.NewString(serialNumber),
+		})
+	} else {
+		volumes, _, err = client.VolumesApi.QueryVolumes(ctx, &hmrest.VolumesApiQueryVolumesOpts{
+			SerialNumber: optional.NewString(serialNumber),
+		})
+	}
+	if err != nil {
+		return hmrest.Volume{}, err
+	}
+	if len(volumes.Items) != 1 {
+		return hmrest.Volume{}, fmt.Errorf("expected one volume with serial number %s, found %d", serialNumber, len(volumes.Items))
+	}
+	return volumes.Items[0], nil
+}
+
+// volumeDataSourceSchema describes a volume as read by the volume data sources, everything is computed
+func volumeDataSourceSchema() map[string]*schema.Schema {
+	computedString := func(description string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Computed: true, Description: description}
+	}
+	computedStrings := func(description string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeList, Computed: true, Description: description, Elem: &schema.Schema{Type: schema.TypeString}}
+	}
+
+	return map[string]*schema.Schema{
+		"id":                     computedString(""),
+		"name":                   computedString(""),
+		"display_name":           computedString(""),
+		"self_link":              computedString(""),
+		"serial_number":          computedString(""),
+		"tenant_name":            computedString(""),
+		"tenant_space_name":      computedString(""),
+		"storage_class_name":     computedString(""),
+		"protection_policy_name": computedString(""),
+		"placement_group_name":   computedString(""),
+		"array_name":             computedString("The array the volume is currently placed on."),
+		"host_access_policies":   computedStrings("Names of the host access policies which can access the volume."),
+		"iscsi_target_iqn":       computedString(""),
+		"iscsi_target_addresses": computedStrings("Addresses of the iSCSI portals the volume can be reached through."),
+		"size": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "In bytes.",
+		},
+		"created_at": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Milliseconds since the epoch.",
+		},
+		"destroyed": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+// flattenVolume returns the attributes of volumeDataSourceSchema
+func flattenVolume(vol hmrest.Volume) map[string]interface{} {
+	attrs := map[string]interface{}{
+		"id":                     vol.Id,
+		"name":                   vol.Name,
+		"display_name":           vol.DisplayName,
+		"self_link":              vol.SelfLink,
+		"serial_number":          vol.SerialNumber,
+		"tenant_name":            "",
+		"tenant_space_name":      "",
+		"storage_class_name":     "",
+		"protection_policy_name": "",
+		"placement_group_name":   "",
+		"array_name":             "",
+		"iscsi_target_iqn":       "",
+		"iscsi_target_addresses": []string{},
+		"size":                   vol.Size,
+		"created_at":             vol.CreatedAt,
+		"destroyed":              vol.Destroyed,
+	}
+	if vol.Tenant != nil {
+		attrs["tenant_name"] = vol.Tenant.Name
+	}
+	if vol.TenantSpace != nil {
+		attrs["tenant_space_name"] = vol.TenantSpace.Name
+	}
+	if vol.StorageClass != nil {
+		attrs["storage_class_name"] = vol.StorageClass.Name
+	}
+	if vol.ProtectionPolicy != nil {
+		attrs["protection_policy_name"] = vol.ProtectionPolicy.Name
+	}
+	if vol.PlacementGroup != nil {
+		attrs["placement_group_name"] = vol.PlacementGroup.Name
+	}
+	if vol.Array != nil {
+		attrs["array_name"] = vol.Array.Name
+	}
+	hostAccessPolicies := []string{}
+	for _, hap := range vol.HostAccessPolicies {
+		hostAccessPolicies = append(hostAccessPolicies, hap.Name)
+	}
+	attrs["host_access_policies"] = hostAccessPolicies
+	if vol.Target != nil && vol.Target.Iscsi != nil {
+		attrs["iscsi_target_iqn"] = vol.Target.Iscsi.Iqn
+		if vol.Target.Iscsi.Addresses != nil {
+			attrs["iscsi_target_addresses"] = vol.Target.Iscsi.Addresses
+		}
+	}
+	return attrs
+}
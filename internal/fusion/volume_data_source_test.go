@@ -0,0 +1,75 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Looks up the same volume by name, id and serial number
+func TestAccVolumeDataSource_basic(t *testing.T) {
+	tsName := acctest.RandomWithPrefix("ts-volDataSourceTest")
+	pgName := acctest.RandomWithPrefix("pg-volDataSourceTest")
+	scName := acctest.RandomWithPrefix("sc-volDataSourceTest")
+	volName := acctest.RandomWithPrefix("vol-volDataSourceTest")
+
+	commonConfig := testSnapshotVolumeConfig(tsName, pgName, scName, volName)
+	checkVolume := func(rName string) resource.TestCheckFunc {
+		return resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrPair(rName, "id", "fusion_volume.vol", "id"),
+			resource.TestCheckResourceAttr(rName, "name", volName),
+			resource.TestCheckResourceAttr(rName, "tenant_space_name", tsName),
+			resource.TestCheckResourceAttr(rName, "placement_group_name", pgName),
+			resource.TestCheckResourceAttr(rName, "storage_class_name", scName),
+			resource.TestCheckResourceAttr(rName, "size", "1048576"),
+			resource.TestCheckResourceAttr(rName, "host_access_policies.#", "0"),
+			resource.TestCheckResourceAttrPair(rName, "serial_number", "fusion_volume.vol", "serial_number"),
+			resource.TestCheckResourceAttrSet(rName, "array_name"),
+			resource.TestCheckResourceAttrSet(rName, "iscsi_target_iqn"),
+		)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: commonConfig + `
+				data "fusion_volume" "by_name" {
+					name              = fusion_volume.vol.name
+					tenant_name       = fusion_volume.vol.tenant_name
+					tenant_space_name = fusion_volume.vol.tenant_space_name
+				}
+				data "fusion_volume" "by_id" {
+					id = fusion_volume.vol.id
+				}
+				data "fusion_volume" "by_serial_number" {
+					serial_number = fusion_volume.vol.serial_number
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					checkVolume("data.fusion_volume.by_name"),
+					checkVolume("data.fusion_volume.by_id"),
+					checkVolume("data.fusion_volume.by_serial_number"),
+				),
+			},
+			{
+				Config: commonConfig + `
+				data "fusion_volume" "ambiguous" {
+					id            = fusion_volume.vol.id
+					serial_number = fusion_volume.vol.serial_number
+				}
+				`,
+				ExpectError: regexp.MustCompile("only one of `id,name,serial_number` can be specified"),
+			},
+		},
+	})
+}
@@ -2,10 +2,12 @@ package fusion
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
 	"reflect"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -178,6 +180,232 @@ func TestAccVolume_basic(t *testing.T) {
 	})
 }
 
+func TestAccVolume_fromSource(t *testing.T) {
+	tsName := acctest.RandomWithPrefix("ts-volSrcTest")
+	pgName := acctest.RandomWithPrefix("pg-volSrcTest")
+	scName := acctest.RandomWithPrefix("sc-volSrcTest")
+	volName := acctest.RandomWithPrefix("vol-volSrcTest")
+	snapName := acctest.RandomWithPrefix("snap-volSrcTest")
+	snap2Name := acctest.RandomWithPrefix("snap2-volSrcTest")
+
+	commonConfig := testSnapshotVolumeConfig(tsName, pgName, scName, volName) +
+		testSnapshotConfig("snap", snapName, "fusion_tenant_space.ts.name", `volume_names = [fusion_volume.vol.name]`) +
+		testSnapshotConfig("snap2", snap2Name, "fusion_tenant_space.ts.name", `volume_names = [fusion_volume.vol.name]`)
+
+	cloneConfig := func(rName, source string) string {
+		return fmt.Sprintf(`
+	resource "fusion_volume" "%[1]s" {
+		name                 = "%[1]s-%[2]s"
+		tenant_name          = fusion_tenant_space.ts.tenant_name
+		tenant_space_name    = fusion_tenant_space.ts.name
+		storage_class_name   = fusion_storage_class.sc.name
+		placement_group_name = fusion_placement_group.pg.name
+		size                 = 1048576
+		host_names           = []
+		%[3]s
+	}
+	`, rName, volName, source)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckVolumeDestroy,
+		Steps: []resource.TestStep{
+			// Clone by name
+			{
+				Config: commonConfig +
+					cloneConfig("from_snap", `source_volume_snapshot = "${fusion_snapshot.snap.name}/${fusion_snapshot.snap.volume_snapshots.0.name}"`) +
+					cloneConfig("from_vol", `source_volume = fusion_volume.vol.name`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("fusion_volume.from_snap", "serial_number"),
+					resource.TestCheckResourceAttrSet("fusion_volume.from_vol", "serial_number"),
+				),
+			},
+			// Refresh the contents of an existing volume from another snapshot, by self_link
+			{
+				Config: commonConfig +
+					cloneConfig("from_snap", `source_volume_snapshot = fusion_snapshot.snap2.volume_snapshots.0.self_link`) +
+					cloneConfig("from_vol", `source_volume = fusion_volume.vol.name`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("fusion_volume.from_snap", "source_volume_snapshot",
+						"fusion_snapshot.snap2", "volume_snapshots.0.self_link"),
+				),
+			},
+			{
+				Config: commonConfig +
+					cloneConfig("bad_ref", `source_volume_snapshot = "no-slash-here"`),
+				ExpectError: regexp.MustCompile("source_volume_snapshot must be a self_link or"),
+			},
+		},
+	})
+}
+
+// Sizes with units, and the plan time guards against shrinking and exceeding the storage class size_limit
+func TestAccVolume_size(t *testing.T) {
+	tsName := acctest.RandomWithPrefix("ts-volSizeTest")
+	pgName := acctest.RandomWithPrefix("pg-volSizeTest")
+	scName := acctest.RandomWithPrefix("sc-volSizeTest")
+	volName := acctest.RandomWithPrefix("vol-volSizeTest")
+
+	// The storage class in the shared config has a size_limit of 1T
+	commonConfig := testSnapshotVolumeConfig(tsName, pgName, scName, volName)
+	sizedConfig := func(size string) string {
+		return fmt.Sprintf(`
+	resource "fusion_volume" "sized" {
+		name                 = "%[1]s-sized"
+		tenant_name          = fusion_tenant_space.ts.tenant_name
+		tenant_space_name    = fusion_tenant_space.ts.name
+		storage_class_name   = fusion_storage_class.sc.name
+		placement_group_name = fusion_placement_group.pg.name
+		size                 = "%[2]s"
+		host_names           = []
+	}
+	`, volName, size)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: commonConfig + sizedConfig("1G"),
+				Check:  resource.TestCheckResourceAttr("fusion_volume.sized", "size", "1G"),
+			},
+			// Equivalent sizes must not produce a diff
+			{
+				Config:   commonConfig + sizedConfig("1024M"),
+				PlanOnly: true,
+			},
+			{
+				Config:      commonConfig + sizedConfig("512M"),
+				ExpectError: regexp.MustCompile("volumes can only be extended"),
+			},
+			{
+				Config:      commonConfig + sizedConfig("2T"),
+				ExpectError: regexp.MustCompile("exceeds the size_limit 1T"),
+			},
+			{
+				Config: commonConfig + sizedConfig("2G"),
+				Check:  resource.TestCheckResourceAttr("fusion_volume.sized", "size", "2G"),
+			},
+		},
+	})
+}
+
+// Destroys a volume without eradicating it and gets it back by re-adding it to the config
+func TestAccVolume_destroyAndRecover(t *testing.T) {
+	tsName := acctest.RandomWithPrefix("ts-volRecoverTest")
+	pgName := acctest.RandomWithPrefix("pg-volRecoverTest")
+	scName := acctest.RandomWithPrefix("sc-volRecoverTest")
+	volName := acctest.RandomWithPrefix("vol-volRecoverTest")
+
+	// The shared config already contains a volume, recovery is tested on a second one
+	commonConfig := testSnapshotVolumeConfig(tsName, pgName, scName, volName)
+	recoverableConfig := func(deletionMode string) string {
+		return fmt.Sprintf(`
+	resource "fusion_volume" "recoverable" {
+		name                 = "%[1]s-recoverable"
+		tenant_name          = fusion_tenant_space.ts.tenant_name
+		tenant_space_name    = fusion_tenant_space.ts.name
+		storage_class_name   = fusion_storage_class.sc.name
+		placement_group_name = fusion_placement_group.pg.name
+		size                 = 1048576
+		host_names           = []
+		deletion_mode        = "%[2]s"
+	}
+	`, volName, deletionMode)
+	}
+
+	var serialNumber string
+	saveSerialNumber := func(s *terraform.State) error {
+		serialNumber = s.RootModule().Resources["fusion_volume.recoverable"].Primary.Attributes["serial_number"]
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: commonConfig + recoverableConfig("destroy"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fusion_volume.recoverable", "destroyed", "false"),
+					saveSerialNumber,
+				),
+			},
+			{
+				Config: commonConfig,
+				Check:  testVolumeDestroyed(testAccTenant, tsName, volName+"-recoverable"),
+			},
+			{
+				Config: commonConfig + recoverableConfig("destroy"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fusion_volume.recoverable", "destroyed", "false"),
+					func(s *terraform.State) error {
+						return resource.TestCheckResourceAttr("fusion_volume.recoverable", "serial_number", serialNumber)(s)
+					},
+				),
+			},
+			// Eradicate on teardown, otherwise the tenant space can't be deleted
+			{
+				Config: commonConfig + recoverableConfig("eradicate"),
+				Check:  resource.TestCheckResourceAttr("fusion_volume.recoverable", "deletion_mode", "eradicate"),
+			},
+		},
+	})
+}
+
+func TestUpgradeVolumeStateV0(t *testing.T) {
+	for _, size := range []interface{}{json.Number("1099511627776"), float64(1099511627776)} {
+		state, err := upgradeVolumeStateV0(context.Background(), map[string]interface{}{"name": "vol", "size": size}, nil)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %s", size, err)
+		}
+		if state["size"] != "1099511627776" || state["name"] != "vol" {
+			t.Errorf("%v: expected the size in bytes as a string, got %v", size, state)
+		}
+		if parsed, err := parseSize(state["size"].(string)); err != nil || parsed != 1099511627776 {
+			t.Errorf("%v: expected the upgraded size to parse back, got %d (%v)", size, parsed, err)
+		}
+	}
+	if _, err := upgradeVolumeStateV0(context.Background(), map[string]interface{}{"size": true}, nil); err == nil {
+		t.Errorf("expected an error for a size which isn't a number")
+	}
+}
+
+func TestParseVolumeSnapshotRef(t *testing.T) {
+	for ref, expected := range map[string][]string{
+		"/tenants/t/tenant-spaces/ts/snapshots/snap/volume-snapshots/vs": {"/tenants/t/tenant-spaces/ts/snapshots/snap/volume-snapshots/vs"},
+		"snap/vs": {"snap", "vs"},
+	} {
+		parts, err := parseVolumeSnapshotRef(ref)
+		if err != nil || !reflect.DeepEqual(parts, expected) {
+			t.Errorf("%q: expected %v, got %v (%v)", ref, expected, parts, err)
+		}
+	}
+	for _, ref := range []string{"", "snap", "snap/", "snap//vs", "snap/vs/extra"} {
+		if _, err := parseVolumeSnapshotRef(ref); err == nil {
+			t.Errorf("%q: expected error", ref)
+		}
+	}
+}
+
+func testVolumeDestroyed(tenantName, tenantSpaceName, volumeName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		volume, _, err := testAccProvider.Meta().(*hmrest.APIClient).VolumesApi.GetVolume(context.Background(), tenantName, tenantSpaceName, volumeName, nil)
+		if err != nil {
+			return fmt.Errorf("go client returned error while searching for %s. Error: %s", volumeName, err)
+		}
+		if !volume.Destroyed {
+			return fmt.Errorf("volume %s was expected to be destroyed", volumeName)
+		}
+		return nil
+	}
+}
+
 // Verify resource with a direct hmrest call
 func testVolumeExists(rName string, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -0,0 +1,177 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	hmrest "github.com/PureStorage-OpenConnect/terraform-provider-fusion/internal/hmrest"
+)
+
+// Implements DataSourceProvider
+type volumesDataSourceProvider struct{}
+
+// This is our entry point for the Volumes data source
+func dataSourceVolumes() *schema.Resource {
+	ds := NewBaseDataSourceFunctions("Volumes", &volumesDataSourceProvider{})
+
+	optionalString := func(description string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Optional: true, Description: description}
+	}
+
+	ds.Resource.Schema = map[string]*schema.Schema{
+		"tenant_name": optionalString("Only list the volumes of this tenant, all the tenants the caller can see when not set."),
+		"tenant_space_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"tenant_name"},
+			Description:  "Only list the volumes of this tenant space.",
+		},
+		"filter":                    optionalString("A Fusion filter expression, e.g. `size > 1073741824`"),
+		"sort":                      optionalString("e.g. `name:desc`"),
+		"name":                      optionalString(""),
+		"display_name":              optionalString(""),
+		"serial_number":             optionalString(""),
+		"storage_class_id":          optionalString(""),
+		"placement_group_id":        optionalString(""),
+		"protection_policy_id":      optionalString(""),
+		"array_id":                  optionalString(""),
+		"source_volume_snapshot_id": optionalString(""),
+		"host_access_policy_id":     optionalString(""),
+		"iqn":                       optionalString(""),
+		"destroyed": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Only list destroyed volumes when true, or volumes which are not destroyed when false.",
+		},
+		"offset": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			Description:      "Skip this many volumes.",
+		},
+		"limit": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			Description:      "Return at most this many volumes, all of them when not set.",
+		},
+		"volumes": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: volumeDataSourceSchema(),
+			},
+		},
+	}
+
+	return ds.Resource
+}
+
+func (ds *volumesDataSourceProvider) ReadDataSource(ctx context.Context, client *hmrest.APIClient, d *schema.ResourceData) error {
+	tenantName := rdString(ctx, d, "tenant_name")
+	tenantSpaceName := rdString(ctx, d, "tenant_space_name")
+	opts := volumesQueryOpts(ctx, d)
+	limit := rdInt(d, "limit")
+
+	// Volumes of a single tenant space are listed, anything else is a query across tenant spaces
+	var listPage func(opts hmrest.VolumesApiQueryVolumesOpts) (hmrest.VolumeList, error)
+	if tenantSpaceName != "" && !opts.HostAccessPolicyId.IsSet() {
+		listPage = func(opts hmrest.VolumesApiQueryVolumesOpts) (hmrest.VolumeList, error) {
+			listOpts := listVolumesOpts(opts)
+			volumes, _, err := client.VolumesApi.ListVolumes(ctx, tenantName, tenantSpaceName, &listOpts)
+			return volumes, err
+		}
+	} else {
+		if tenantName != "" {
+			tenant, _, err := client.TenantsApi.GetTenant(ctx, tenantName, nil)
+			if err != nil {
+				return err
+			}
+			opts.TenantId = optional.NewString(tenant.Id)
+		}
+		if tenantSpaceName != "" {
+			tenantSpace, _, err := client.TenantSpacesApi.GetTenantSpace(ctx, tenantName, tenantSpaceName, nil)
+			if err != nil {
+				return err
+			}
+			opts.TenantSpaceId = optional.NewString(tenantSpace.Id)
+		}
+		listPage = func(opts hmrest.VolumesApiQueryVolumesOpts) (hmrest.VolumeList, error) {
+			volumes, _, err := client.VolumesApi.QueryVolumes(ctx, &opts)
+			return volumes, err
+		}
+	}
+
+	offset := rdInt(d, "offset")
+	id := dataSourceQueryId(tenantName, tenantSpaceName, opts, offset, limit)
+	items := []map[string]interface{}{}
+	err := listAllPages(ctx, offset, limit, func(offset, pageSize int32) (int, bool, error) {
+		opts.Offset = optional.NewInt32(offset)
+		opts.Limit = optional.NewInt32(pageSize)
+		page, err := listPage(opts)
+		for _, vol := range page.Items {
+			items = append(items, flattenVolume(vol))
+		}
+		return len(page.Items), page.MoreItemsRemaining, err
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+	return d.Set("volumes", items)
+}
+
+func volumesQueryOpts(ctx context.Context, d *schema.ResourceData) hmrest.VolumesApiQueryVolumesOpts {
+	var opts hmrest.VolumesApiQueryVolumesOpts
+	for key, opt := range map[string]*optional.String{
+		"filter":                    &opts.Filter,
+		"sort":                      &opts.Sort,
+		"name":                      &opts.Name,
+		"display_name":              &opts.DisplayName,
+		"serial_number":             &opts.SerialNumber,
+		"storage_class_id":          &opts.StorageClassId,
+		"placement_group_id":        &opts.PlacementGroupId,
+		"protection_policy_id":      &opts.ProtectionPolicyId,
+		"array_id":                  &opts.ArrayId,
+		"source_volume_snapshot_id": &opts.SourceVolumeSnapshotId,
+		"host_access_policy_id":     &opts.HostAccessPolicyId,
+		"iqn":                       &opts.Iqn,
+	} {
+		if value := rdString(ctx, d, key); value != "" {
+			*opt = optional.NewString(value)
+		}
+	}
+	// false is a valid filter, tell it apart from not being set
+	if !d.GetRawConfig().GetAttr("destroyed").IsNull() {
+		opts.Destroyed = optional.NewBool(d.Get("destroyed").(bool))
+	}
+	return opts
+}
+
+// listVolumesOpts has the same filters, minus the ones which only make sense across tenant spaces
+func listVolumesOpts(opts hmrest.VolumesApiQueryVolumesOpts) hmrest.VolumesApiListVolumesOpts {
+	return hmrest.VolumesApiListVolumesOpts{
+		Filter:                 opts.Filter,
+		Sort:                   opts.Sort,
+		Limit:                  opts.Limit,
+		Offset:                 opts.Offset,
+		Name:                   opts.Name,
+		DisplayName:            opts.DisplayName,
+		SerialNumber:           opts.SerialNumber,
+		StorageClassId:         opts.StorageClassId,
+		PlacementGroupId:       opts.PlacementGroupId,
+		ProtectionPolicyId:     opts.ProtectionPolicyId,
+		ArrayId:                opts.ArrayId,
+		SourceVolumeSnapshotId: opts.SourceVolumeSnapshotId,
+		Iqn:                    opts.Iqn,
+		Destroyed:              opts.Destroyed,
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 Pure Storage Inc
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fusion
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Lists the volumes of a tenant space, and queries them across tenant spaces
+func TestAccVolumesDataSource_basic(t *testing.T) {
+	tsName := acctest.RandomWithPrefix("ts-volsDataSourceTest")
+	pgName := acctest.RandomWithPrefix("pg-volsDataSourceTest")
+	scName := acctest.RandomWithPrefix("sc-volsDataSourceTest")
+	volName := acctest.RandomWithPrefix("vol-volsDataSourceTest")
+
+	commonConfig := testSnapshotVolumeConfig(tsName, pgName, scName, volName) + fmt.Sprintf(`
+	resource "fusion_volume" "vol2" {
+		name                 = "%[1]s-2"
+		tenant_name          = fusion_tenant_space.ts.tenant_name
+		tenant_space_name    = fusion_tenant_space.ts.name
+		storage_class_name   = fusion_storage_class.sc.name
+		placement_group_name = fusion_placement_group.pg.name
+		size                 = 2097152
+		host_names           = []
+	}
+	`, volName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactory,
+		CheckDestroy:      testCheckVolumeDestroy,
+		Steps: []resource.TestStep{
+			// The data sources don't depend on the volumes, create them first
+			{
+				Config: commonConfig,
+			},
+			{
+				Config: commonConfig + `
+				data "fusion_volumes" "tenant_space" {
+					tenant_name       = fusion_tenant_space.ts.tenant_name
+					tenant_space_name = fusion_tenant_space.ts.name
+					sort              = "name"
+				}
+				data "fusion_volumes" "limited" {
+					tenant_name       = fusion_tenant_space.ts.tenant_name
+					tenant_space_name = fusion_tenant_space.ts.name
+					sort              = "name"
+					offset            = 1
+					limit             = 1
+				}
+				data "fusion_volumes" "query" {
+					placement_group_id = fusion_placement_group.pg.id
+					destroyed          = false
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fusion_volumes.tenant_space", "volumes.#", "2"),
+					resource.TestCheckResourceAttr("data.fusion_volumes.tenant_space", "volumes.0.name", volName),
+					resource.TestCheckResourceAttr("data.fusion_volumes.tenant_space", "volumes.1.name", volName+"-2"),
+					resource.TestCheckResourceAttr("data.fusion_volumes.tenant_space", "volumes.1.size", "2097152"),
+					resource.TestCheckResourceAttr("data.fusion_volumes.limited", "volumes.#", "1"),
+					resource.TestCheckResourceAttr("data.fusion_volumes.limited", "volumes.0.name", volName+"-2"),
+					resource.TestCheckResourceAttr("data.fusion_volumes.query", "volumes.#", "2"),
+					resource.TestCheckResourceAttr("data.fusion_volumes.query", "volumes.0.tenant_space_name", tsName),
+				),
+			},
+		},
+	})
+}
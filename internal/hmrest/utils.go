@@ -12,6 +12,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
 
 	"github.com/antihax/optional"
 )
@@ -219,3 +222,89 @@ func (a *VolumesApiService) UpdateVolumeSize(
 	patch := VolumePatch{Size: &NullableSize{Value: size}}
 	return a.UpdateVolumeBy(ctx, tenantName, tenantSpaceName, volumeName, patch, requestId)
 }
+
+// Recover a destroyed volume.
+// This can't go through VolumePatch, since NullableBoolean omits a false value and the
+// server would see {"destroyed": {}} instead of {"destroyed": {"value": false}}.
+func (a *VolumesApiService) RecoverVolume(
+	ctx context.Context,
+	tenantName string,
+	tenantSpaceName string,
+	volumeName string,
+	requestId string) (Operation, error) {
+	path := a.client.cfg.BasePath + "/tenants/" + url.PathEscape(tenantName) +
+		"/tenant-spaces/" + url.PathEscape(tenantSpaceName) +
+		"/volumes/" + url.PathEscape(volumeName)
+	body := map[string]interface{}{
+		"destroyed": map[string]interface{}{"value": false},
+	}
+	op, err := a.client.patchForOperation(ctx, path, body, requestId)
+	if err != nil {
+		return Operation{}, fmt.Errorf("error recovering volume: %w", err)
+	}
+	return op, nil
+}
+
+// patchForOperation sends an arbitrary JSON PATCH body and decodes the resulting Operation,
+// for the few cases the generated models can't express.
+func (c *APIClient) patchForOperation(ctx context.Context, path string, body interface{}, requestId string) (Operation, error) {
+	var op Operation
+	headers := map[string]string{
+		"Content-Type": "application/json",
+		"Accept":       "application/json",
+	}
+	if requestId != "" {
+		headers["X-Request-ID"] = requestId
+	}
+	r, err := c.prepareRequest(ctx, path, strings.ToUpper("Patch"), body, headers, url.Values{}, url.Values{}, "", nil)
+	if err != nil {
+		return op, err
+	}
+
+	resp, err := c.callAPI(r)
+	if err != nil || resp == nil {
+		return op, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return op, err
+	}
+
+	if resp.StatusCode >= 300 {
+		newErr := GenericSwaggerError{
+			body:  respBody,
+			error: resp.Status,
+		}
+		var v ErrorResponse
+		if err := c.decode(&v, respBody, resp.Header.Get("Content-Type")); err == nil {
+			newErr.model = v
+		}
+		return op, newErr
+	}
+	err = c.decode(&op, respBody, resp.Header.Get("Content-Type"))
+	return op, err
+}
+
+// Set maintenance_mode or unavailable_mode of an array.
+// Like RecoverVolume, this can't go through ArrayPatch when turning a mode off.
+func (a *ArraysApiService) UpdateArrayMode(
+	ctx context.Context,
+	regionName string,
+	availabilityZoneName string,
+	arrayName string,
+	mode string,
+	value bool,
+	requestId string) (Operation, error) {
+	path := a.client.cfg.BasePath + "/regions/" + url.PathEscape(regionName) +
+		"/availability-zones/" + url.PathEscape(availabilityZoneName) +
+		"/arrays/" + url.PathEscape(arrayName)
+	body := map[string]interface{}{
+		mode: map[string]interface{}{"value": value},
+	}
+	op, err := a.client.patchForOperation(ctx, path, body, requestId)
+	if err != nil {
+		return Operation{}, fmt.Errorf("error updating %s of array: %w", mode, err)
+	}
+	return op, nil
+}